@@ -0,0 +1,307 @@
+package godbf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// The AddXxxField methods only alter the schema of a table with no records. The methods below alter the schema of any
+// table, rebuilding its header and re-laying out every record to match. Fields that are unchanged keep their content
+// as is, and fields that change are converted value by value through their text, as FieldValue and SetFieldValue
+// render and parse it.
+
+// ConversionFailure records a value that could not be converted when a field's length or type was changed by
+// ResizeField or ChangeFieldType. The field is left empty in the row.
+type ConversionFailure struct {
+	Row       int
+	FieldName string
+	Value     string
+	Err       error
+}
+
+// AddField adds a field of the given type to the table, as the AddXxxField methods do, but also to a table that has
+// records, or was read from a file. The field is empty in existing records, except for an Autoincrement field, which
+// numbers them in order.
+// The length of fixed-length types, and the decimal places of types that do not use them, are ignored.
+// An error is returned if the table already has a field of the given name, or cannot hold a field of the given type.
+func (dt *DbfTable) AddField(fieldName string, fieldType DbaseDataType, length byte, decimalPlaces uint8) error {
+	fields := slices.Clone(dt.fields)
+	fields = append(fields, FieldDescriptor{name: fieldName, fieldType: fieldType, length: length, decimalPlaces: decimalPlaces})
+
+	sources := make([]int, len(fields))
+	for i := range sources {
+		sources[i] = i
+	}
+	sources[len(fields)-1] = -1
+
+	_, alterErr := dt.alterFields(fields, sources)
+	return alterErr
+}
+
+// DropField removes the field with the given name from the table, and its content from every record.
+// Memo content referenced by the field stays in the table's memo file until the table is packed.
+// If the field does not exist, or is the table's only field, an error is returned.
+func (dt *DbfTable) DropField(fieldName string) error {
+	fieldIndex, found := dt.fieldMap[fieldName]
+	if !found {
+		return &FieldNotFoundError{FieldName: fieldName}
+	}
+	if len(dt.fields) == 1 {
+		return errors.New("Field \"" + fieldName + "\" is the only field of the table, and cannot be dropped")
+	}
+
+	fields := slices.Delete(slices.Clone(dt.fields), fieldIndex, fieldIndex+1)
+
+	sources := make([]int, 0, len(fields))
+	for i := range dt.fields {
+		if i != fieldIndex {
+			sources = append(sources, i)
+		}
+	}
+
+	_, alterErr := dt.alterFields(fields, sources)
+	return alterErr
+}
+
+// RenameField gives the field with the given name a new name, leaving its content unchanged.
+// If the field does not exist, or the table already has a field of the new name, an error is returned.
+func (dt *DbfTable) RenameField(fieldName string, newFieldName string) error {
+	fieldIndex, found := dt.fieldMap[fieldName]
+	if !found {
+		return &FieldNotFoundError{FieldName: fieldName}
+	}
+
+	fields := slices.Clone(dt.fields)
+	fields[fieldIndex].name = newFieldName
+
+	_, alterErr := dt.alterFields(fields, identitySources(len(fields)))
+	return alterErr
+}
+
+// ResizeField changes the length, and decimal places, of the Character, Numeric or Float field with the given name,
+// converting its value in every record. Numeric and Float values are rounded to the new decimal places.
+// Values that no longer fit are left empty, and reported in the failures returned, unless SetTruncation(true) has
+// been called, in which case they are truncated as SetFieldValue truncates them.
+// If the field does not exist, or is of a type with a fixed length, an error is returned.
+func (dt *DbfTable) ResizeField(fieldName string, length byte, decimalPlaces uint8) ([]ConversionFailure, error) {
+	fieldIndex, found := dt.fieldMap[fieldName]
+	if !found {
+		return nil, &FieldNotFoundError{FieldName: fieldName}
+	}
+
+	field := dt.fields[fieldIndex]
+	if field.fieldType.fixedFieldLength() != notApplicable || dt.storesInMemo(field) || dt.storesBinary(field) {
+		return nil, errors.New("type of field \"" + fieldName + "\" is " + field.fieldType.name() + ", which has a fixed length")
+	}
+
+	fields := slices.Clone(dt.fields)
+	fields[fieldIndex].length = length
+	if field.usesDecimalPlaces() {
+		fields[fieldIndex].decimalPlaces = decimalPlaces
+	}
+
+	return dt.alterFields(fields, identitySources(len(fields)))
+}
+
+// ChangeFieldType changes the field with the given name to a field of the given type, length and decimal places,
+// converting its value in every record where possible: numbers convert between Character and numeric types, dates
+// between Character, Date and date and time types, and text between Character and Memo. Values that cannot be
+// converted are left empty, and reported in the failures returned.
+// The length of fixed-length types, and the decimal places of types that do not use them, are ignored.
+// If the field does not exist, or the table cannot hold a field of the given type, an error is returned.
+func (dt *DbfTable) ChangeFieldType(fieldName string, fieldType DbaseDataType, length byte, decimalPlaces uint8) ([]ConversionFailure, error) {
+	fieldIndex, found := dt.fieldMap[fieldName]
+	if !found {
+		return nil, &FieldNotFoundError{FieldName: fieldName}
+	}
+
+	fields := slices.Clone(dt.fields)
+	fields[fieldIndex] = FieldDescriptor{name: fieldName, fieldType: fieldType, length: length, decimalPlaces: decimalPlaces}
+
+	return dt.alterFields(fields, identitySources(len(fields)))
+}
+
+// identitySources returns the sources of a schema alteration that keeps each of the given number of fields in place.
+func identitySources(numberOfFields int) []int {
+	sources := make([]int, numberOfFields)
+	for i := range sources {
+		sources[i] = i
+	}
+	return sources
+}
+
+// alterFields replaces the fields of the table with the given fields, re-laying out every record to match. sources
+// holds, for each of the new fields, the index of the existing field its content comes from, or -1 for a field that
+// is new. The table is left unchanged if the new fields cannot be added.
+func (dt *DbfTable) alterFields(fields []FieldDescriptor, sources []int) ([]ConversionFailure, error) {
+	if writableErr := dt.verifyWritable(); writableErr != nil {
+		return nil, writableErr
+	}
+	if dt.storage != nil {
+		return nil, errors.New("tables opened with OpenFile cannot have their schema altered")
+	}
+
+	original := *dt
+	if dt.nullFlagsField != nil {
+		nullFlagsField := *dt.nullFlagsField
+		dt.nullFlagsField = &nullFlagsField
+	}
+
+	if rebuildErr := dt.rebuildHeader(fields, sources); rebuildErr != nil {
+		*dt = original
+		return nil, rebuildErr
+	}
+
+	recordLength := int(dt.lengthOfEachRecord)
+	recordsLength := int(dt.numberOfRecords) * recordLength
+	dt.dataStore = slices.Grow(dt.dataStore[:len(dt.dataStore)-1], recordsLength+1)
+	// tables with record storage are refused above, so reading their records cannot fail
+	for row := range int(dt.numberOfRecords) {
+		record := make([]byte, recordLength)
+		originalRecord, _ := original.recordBytes(row)
+		record[recordDeletionFlagIndex] = originalRecord[recordDeletionFlagIndex]
+		dt.dataStore = append(dt.dataStore, record...)
+	}
+	dt.dataStore = append(dt.dataStore, dt.eofMarker)
+
+	var failures []ConversionFailure
+	for fieldIndex, source := range sources {
+		switch {
+		case source < 0:
+			dt.initialiseField(fieldIndex)
+		case sameLayout(original.fields[source], dt.fields[fieldIndex]):
+			for row := range int(dt.numberOfRecords) {
+				fieldBytes, _ := dt.fieldBytes(row, fieldIndex)
+				originalBytes, _ := original.fieldBytes(row, source)
+				copy(fieldBytes, originalBytes)
+				if original.IsNull(row, source) {
+					dt.SetNullByName(row, dt.fields[fieldIndex].name)
+				}
+			}
+		default:
+			failures = append(failures, dt.convertField(&original, source, fieldIndex)...)
+		}
+	}
+
+	return failures, nil
+}
+
+// rebuildHeader replaces the fields of the table with the given fields, adding them as the AddXxxField methods do,
+// and rebuilds the table's header, without its records. dBase 7 field properties follow their fields to their new
+// positions, and are removed for fields that are dropped, or change type.
+func (dt *DbfTable) rebuildHeader(fields []FieldDescriptor, sources []int) error {
+	previousFields := dt.fields
+	wasLocked := dt.schemaLocked
+
+	dt.schemaLocked = false
+	dt.fields = nil
+	dt.fieldMap = make(map[string]int)
+	dt.dataStore = slices.Clone(dt.dataStore[:dt.headerPrefixLength()])
+
+	for i, field := range fields {
+		var addErr error
+		if source := sources[i]; source >= 0 && field.fieldType == previousFields[source].fieldType {
+			addErr = dt.addField(field.name, field.fieldType, field.length, field.decimalPlaces)
+		} else {
+			addErr = dt.addFieldOfType(field.name, field.fieldType, field.length, field.decimalPlaces)
+		}
+		if addErr != nil {
+			return addErr
+		}
+
+		added := &dt.fields[len(dt.fields)-1]
+		if added.fieldType == field.fieldType {
+			added.flags = field.flags
+			added.fieldStore[fieldFlagsOffset] = field.flags
+			added.productionIndexFlag = field.productionIndexFlag
+			if field.nextAutoincrementValue != 0 {
+				added.nextAutoincrementValue = field.nextAutoincrementValue
+			}
+		}
+	}
+
+	fieldNumbers := make(map[int]int)
+	for i, source := range sources {
+		if source >= 0 && fields[i].fieldType == previousFields[source].fieldType {
+			fieldNumbers[source+1] = i + 1
+		}
+	}
+	dt.renumberFieldProperties(fieldNumbers)
+
+	dt.numberOfFields = len(dt.fields)
+	dt.updateDataStore()
+	dt.schemaLocked = wasLocked
+	return nil
+}
+
+// sameLayout returns true if the content of a field can be copied as is from the existing field to the new field.
+func sameLayout(existing FieldDescriptor, altered FieldDescriptor) bool {
+	return existing.fieldType == altered.fieldType &&
+		existing.length == altered.length &&
+		existing.decimalPlaces == altered.decimalPlaces
+}
+
+// initialiseField sets the new field at the given index to empty in every record, or for an Autoincrement field, to
+// the next value in sequence.
+func (dt *DbfTable) initialiseField(fieldIndex int) {
+	field := &dt.fields[fieldIndex]
+	for row := range int(dt.numberOfRecords) {
+		if field.fieldType != Autoincrement {
+			dt.SetFieldValue(row, fieldIndex, "")
+			continue
+		}
+		fieldBytes, _ := dt.fieldBytes(row, fieldIndex)
+		dt.encodeIntegerField(fieldBytes, int32(field.nextAutoincrementValue))
+		field.nextAutoincrementValue++
+	}
+
+	if field.fieldType == Autoincrement {
+		descriptorOffset := level7HeaderLength + fieldIndex*level7FieldDescriptorLength
+		binary.LittleEndian.PutUint32(dt.dataStore[descriptorOffset+level7AutoincrementOffset:], field.nextAutoincrementValue)
+	}
+}
+
+// convertField sets the field at the given index to the value of the source field of the original table in every
+// record, converted to the field's type. It returns the values that could not be converted, which are left empty.
+func (dt *DbfTable) convertField(original *DbfTable, source int, fieldIndex int) []ConversionFailure {
+	var failures []ConversionFailure
+	for row := range int(dt.numberOfRecords) {
+		if original.IsNull(row, source) && dt.fields[fieldIndex].IsNullable() {
+			dt.SetNullByName(row, dt.fields[fieldIndex].name)
+			continue
+		}
+
+		value := original.FieldValue(row, source)
+		if setErr := dt.SetFieldValue(row, fieldIndex, convertValue(value, dt.fields[fieldIndex])); setErr != nil {
+			failures = append(failures, ConversionFailure{Row: row, FieldName: dt.fields[fieldIndex].name, Value: value, Err: setErr})
+			dt.SetFieldValue(row, fieldIndex, "")
+		}
+	}
+	return failures
+}
+
+// convertValue returns the text of a value of another field type in the form SetFieldValue expects of the field fd:
+// numbers are rounded to the field's decimal places, or to a whole number for integer fields, and dates and times are
+// cut to a date for Date fields. Other values are returned as is.
+func convertValue(value string, fd FieldDescriptor) string {
+	switch fd.fieldType {
+	case Numeric, Float:
+		if number, parseErr := strconv.ParseFloat(value, 64); parseErr == nil && !math.IsInf(number, 0) && !math.IsNaN(number) {
+			return strconv.FormatFloat(number, 'f', int(fd.decimalPlaces), 64)
+		}
+	case Integer, Autoincrement:
+		if number, parseErr := strconv.ParseFloat(value, 64); parseErr == nil && number == math.Trunc(number) {
+			return fmt.Sprintf("%.0f", number)
+		}
+	case Date:
+		if dateTime, parseErr := time.Parse(DateTimeFormat, value); parseErr == nil {
+			return dateTime.Format(DateFormat)
+		}
+	}
+	return value
+}
@@ -0,0 +1,253 @@
+package godbf
+
+import (
+	"bytes"
+	"errors"
+	. "github.com/onsi/gomega"
+	"testing"
+)
+
+func reloadTable(g *GomegaWithT, table *DbfTable) *DbfTable {
+	var content bytes.Buffer
+	g.Expect(writeContent(table, &content)).To(Succeed())
+
+	reloadedTable, loadErr := NewFromByteArray(content.Bytes(), testEncoding)
+	g.Expect(loadErr).To(BeNil())
+	return reloadedTable
+}
+
+func TestDbfTable_AddField_PopulatedTable_ExistingValuesKept(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromFile(validTestFile, testEncoding)
+	g.Expect(tableUnderTest.AddTextField("EXTRA", 5)).ToNot(Succeed())
+
+	g.Expect(tableUnderTest.AddField("EXTRA", Character, 5, 0)).To(Succeed())
+	g.Expect(tableUnderTest.SetFieldValueByName(1, "EXTRA", "added")).To(Succeed())
+
+	reloadedTable := reloadTable(g, tableUnderTest)
+	g.Expect(reloadedTable.FieldNames()).To(Equal([]string{"TESTBOOL", "TESTTEXT", "TESTDATE", "TESTNUM", "TESTFLOAT", "EXTRA"}))
+	g.Expect(reloadedTable.GetRowAsSlice(0)).To(Equal([]string{"T", "test0", "20180101", "42", "42.01000", ""}))
+	g.Expect(reloadedTable.GetRowAsSlice(1)).To(Equal([]string{"F", "test1", "20180102", "43", "43.02000", "added"}))
+}
+
+func TestDbfTable_AddField_ExistingName_TableUnchanged(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromFile(validTestFile, testEncoding)
+	originalContent := bytes.Clone(tableUnderTest.dataStore)
+
+	g.Expect(tableUnderTest.AddField("TESTTEXT", Character, 5, 0)).ToNot(Succeed())
+	g.Expect(tableUnderTest.AddField("UNKNOWN", DbaseDataType('Z'), 5, 0)).ToNot(Succeed())
+
+	g.Expect(tableUnderTest.dataStore).To(Equal(originalContent))
+	verifyTableIsCorrect(tableUnderTest, g)
+}
+
+func TestDbfTable_AddField_Autoincrement_NumbersExistingRows(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := NewDbase7(testEncoding)
+	tableUnderTest.AddTextField("NAME", 10)
+	for _, name := range []string{"alice", "bob"} {
+		row, _ := tableUnderTest.AddNewRecord()
+		tableUnderTest.SetFieldValueByName(row, "NAME", name)
+	}
+
+	g.Expect(tableUnderTest.AddField("ID", Autoincrement, 0, 0)).To(Succeed())
+	row, _ := tableUnderTest.AddNewRecord()
+	tableUnderTest.SetFieldValueByName(row, "NAME", "carol")
+
+	reloadedTable := reloadTable(g, tableUnderTest)
+	g.Expect(reloadedTable.GetRowAsSlice(0)).To(Equal([]string{"alice", "1"}))
+	g.Expect(reloadedTable.GetRowAsSlice(1)).To(Equal([]string{"bob", "2"}))
+	g.Expect(reloadedTable.GetRowAsSlice(2)).To(Equal([]string{"carol", "3"}))
+}
+
+func TestDbfTable_DropField_RemainingValuesKept(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromFile(validTestFile, testEncoding)
+	tableUnderTest.SetRowIsDeleted(2)
+
+	g.Expect(tableUnderTest.DropField("TESTDATE")).To(Succeed())
+
+	reloadedTable := reloadTable(g, tableUnderTest)
+	g.Expect(reloadedTable.FieldNames()).To(Equal([]string{"TESTBOOL", "TESTTEXT", "TESTNUM", "TESTFLOAT"}))
+	g.Expect(reloadedTable.GetRowAsSlice(1)).To(Equal([]string{"F", "test1", "43", "43.02000"}))
+	g.Expect(reloadedTable.RowIsDeleted(2)).To(BeTrue())
+
+	_, missingErr := reloadedTable.FieldValueByName(0, "TESTDATE")
+	g.Expect(errors.Is(missingErr, ErrFieldNotFound)).To(BeTrue())
+}
+
+func TestDbfTable_DropField_Dbase7_FieldPropertiesRenumbered(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromByteArray(dbase7TestBytes(), testEncoding)
+	g.Expect(tableUnderTest.DropField(testLongFieldName)).To(Succeed())
+
+	expectedProperties := []FieldProperty{
+		{FieldName: "COUNT", Property: DefaultProperty, Value: []byte{0x80, 0x00, 0x00, 0x05}},
+	}
+	g.Expect(tableUnderTest.FieldProperties()).To(Equal(expectedProperties))
+	g.Expect(reloadTable(g, tableUnderTest).FieldProperties()).To(Equal(expectedProperties))
+}
+
+func TestDbfTable_ChangeFieldType_Dbase7_FieldPropertiesOfFieldRemoved(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromByteArray(dbase7TestBytes(), testEncoding)
+	_, changeErr := tableUnderTest.ChangeFieldType("COUNT", Character, 4, 0)
+	g.Expect(changeErr).To(BeNil())
+
+	g.Expect(tableUnderTest.FieldProperties()).To(Equal([]FieldProperty{
+		{FieldName: testLongFieldName, Property: RequiredProperty},
+	}))
+}
+
+func TestDbfTable_DropField_InvalidField_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	tableUnderTest.AddTextField("ONLY", 5)
+
+	g.Expect(errors.Is(tableUnderTest.DropField("MISSING"), ErrFieldNotFound)).To(BeTrue())
+	g.Expect(tableUnderTest.DropField("ONLY")).ToNot(Succeed())
+}
+
+func TestDbfTable_RenameField_ValuesKept(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromFile(validTestFile, testEncoding)
+
+	g.Expect(tableUnderTest.RenameField("TESTTEXT", "LABEL")).To(Succeed())
+	g.Expect(tableUnderTest.RenameField("LABEL", "TESTNUM")).ToNot(Succeed())
+
+	reloadedTable := reloadTable(g, tableUnderTest)
+	g.Expect(reloadedTable.FieldValueByName(2, "LABEL")).To(Equal("test2"))
+	g.Expect(reloadedTable.HasField("TESTTEXT")).To(BeFalse())
+}
+
+func TestDbfTable_ResizeField_ShrunkValuesReported(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	tableUnderTest.AddTextField("NAME", 10)
+	tableUnderTest.AddNumberField("AMOUNT", 8, 2)
+	for _, values := range [][]string{{"bob", "12.25"}, {"charlotte", "3.5"}} {
+		row, _ := tableUnderTest.AddNewRecord()
+		tableUnderTest.SetFieldValueByName(row, "NAME", values[0])
+		tableUnderTest.SetFieldValueByName(row, "AMOUNT", values[1])
+	}
+
+	failures, resizeErr := tableUnderTest.ResizeField("NAME", 5, 0)
+	g.Expect(resizeErr).To(BeNil())
+	g.Expect(failures).To(HaveLen(1))
+	g.Expect(failures[0].Row).To(Equal(1))
+	g.Expect(failures[0].Value).To(Equal("charlotte"))
+	g.Expect(errors.Is(failures[0].Err, ErrInvalidFieldValue)).To(BeTrue())
+
+	failures, resizeErr = tableUnderTest.ResizeField("AMOUNT", 6, 1)
+	g.Expect(resizeErr).To(BeNil())
+	g.Expect(failures).To(BeEmpty())
+
+	reloadedTable := reloadTable(g, tableUnderTest)
+	g.Expect(reloadedTable.Fields()[0].Length()).To(BeNumerically("==", 5))
+	g.Expect(reloadedTable.GetRowAsSlice(0)).To(Equal([]string{"bob", "12.2"}))
+	g.Expect(reloadedTable.GetRowAsSlice(1)).To(Equal([]string{"", "3.5"}))
+}
+
+func TestDbfTable_ResizeField_Truncation_ValuesTruncated(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromFile(validTestFile, testEncoding)
+	tableUnderTest.SetTruncation(true)
+
+	failures, resizeErr := tableUnderTest.ResizeField("TESTTEXT", 4, 0)
+	g.Expect(resizeErr).To(BeNil())
+	g.Expect(failures).To(BeEmpty())
+	g.Expect(tableUnderTest.FieldValueByName(1, "TESTTEXT")).To(Equal("test"))
+
+	_, fixedErr := tableUnderTest.ResizeField("TESTDATE", 10, 0)
+	g.Expect(fixedErr).ToNot(BeNil())
+}
+
+func TestDbfTable_ChangeFieldType_ConvertsValues(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	tableUnderTest.AddTextField("CODE", 10)
+	tableUnderTest.AddNumberField("COUNT", 5, 0)
+	for _, values := range [][]string{{"12", "7"}, {"n/a", "8"}, {"", "9"}} {
+		row, _ := tableUnderTest.AddNewRecord()
+		tableUnderTest.SetFieldValueByName(row, "CODE", values[0])
+		tableUnderTest.SetFieldValueByName(row, "COUNT", values[1])
+	}
+
+	failures, changeErr := tableUnderTest.ChangeFieldType("CODE", Numeric, 6, 2)
+	g.Expect(changeErr).To(BeNil())
+	g.Expect(failures).To(HaveLen(1))
+	g.Expect(failures[0].Row).To(Equal(1))
+	g.Expect(failures[0].Value).To(Equal("n/a"))
+
+	failures, changeErr = tableUnderTest.ChangeFieldType("COUNT", Character, 3, 0)
+	g.Expect(changeErr).To(BeNil())
+	g.Expect(failures).To(BeEmpty())
+
+	reloadedTable := reloadTable(g, tableUnderTest)
+	g.Expect(reloadedTable.Fields()[0].FieldType()).To(Equal(Numeric))
+	g.Expect(reloadedTable.Fields()[1].FieldType()).To(Equal(Character))
+	g.Expect(reloadedTable.GetRowAsSlice(0)).To(Equal([]string{"12.00", "7"}))
+	g.Expect(reloadedTable.GetRowAsSlice(1)).To(Equal([]string{"", "8"}))
+	g.Expect(reloadedTable.GetRowAsSlice(2)).To(Equal([]string{"", "9"}))
+}
+
+func TestDbfTable_ChangeFieldType_ToMemoAndDate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	tableUnderTest.AddTextField("NOTES", 20)
+	tableUnderTest.AddDateTimeField("WHEN")
+	row, _ := tableUnderTest.AddNewRecord()
+	tableUnderTest.SetFieldValueByName(row, "NOTES", "remember the milk")
+	tableUnderTest.SetFieldValueByName(row, "WHEN", "20240229134500")
+
+	_, memoErr := tableUnderTest.ChangeFieldType("NOTES", Memo, 0, 0)
+	g.Expect(memoErr).To(BeNil())
+	_, dateErr := tableUnderTest.ChangeFieldType("WHEN", Date, 0, 0)
+	g.Expect(dateErr).To(BeNil())
+
+	g.Expect(tableUnderTest.memo).ToNot(BeNil())
+	g.Expect(tableUnderTest.GetRowAsSlice(row)).To(Equal([]string{"remember the milk", "20240229"}))
+
+	_, unsupportedErr := tableUnderTest.ChangeFieldType("WHEN", DbaseDataType('Z'), 0, 0)
+	g.Expect(unsupportedErr).ToNot(BeNil())
+	g.Expect(tableUnderTest.GetRowAsSlice(row)).To(Equal([]string{"remember the milk", "20240229"}))
+}
+
+func TestDbfTable_AlterFields_OpenMapped_ErrReadOnly(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, openErr := OpenMapped(validTestFile, testEncoding)
+	g.Expect(openErr).To(BeNil())
+	defer tableUnderTest.Close()
+
+	g.Expect(errors.Is(tableUnderTest.AddField("EXTRA", Character, 5, 0), ErrReadOnly)).To(BeTrue())
+}
+
+func TestDbfTable_AddField_VisualFoxPro_NullsAndBacklinkKept(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromByteArray(visualFoxProTestBytes(), testEncoding)
+
+	g.Expect(tableUnderTest.AddField("CODE", Character, 3, 0)).To(Succeed())
+	g.Expect(tableUnderTest.DropField("AMOUNT")).To(Succeed())
+
+	reloadedTable := reloadTable(g, tableUnderTest)
+	g.Expect(reloadedTable.FieldNames()).To(Equal([]string{"NAME", "CODE"}))
+	g.Expect(reloadedTable.GetRowAsSlice(0)).To(Equal([]string{"alice", ""}))
+	g.Expect(reloadedTable.IsNullByName(0, "NAME")).To(BeFalse())
+	g.Expect(reloadedTable.IsNullByName(1, "NAME")).To(BeTrue())
+	g.Expect(reloadedTable.Backlink()).To(Equal(testBacklink))
+}
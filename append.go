@@ -0,0 +1,87 @@
+package godbf
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// OpenForAppend creates a Writer adding records to the end of the existing table file with the given file name,
+// expecting the supplied encoding. Records are written over the file's end-of-file marker, without rewriting the
+// records already in the file. When the Writer is closed, the end-of-file marker is rewritten, the record count and
+// last update date in the header are updated in place, and the file is closed.
+// If the table has memo fields, or the file's size does not match its header, an error is returned.
+func OpenForAppend(fileName string, fileEncoding string) (tableWriter *Writer, openErr error) {
+	f, fileErr := fsWrapper.OpenFile(fileName, os.O_RDWR, 0)
+	if fileErr != nil {
+		return nil, fileErr
+	}
+
+	defer func() {
+		if openErr != nil {
+			f.Close()
+		}
+	}()
+
+	info, statErr := f.Stat()
+	if statErr != nil {
+		return nil, statErr
+	}
+
+	headerBytes, headerErr := readHeader(io.NewSectionReader(f, 0, info.Size()))
+	if headerErr != nil {
+		return nil, headerErr
+	}
+
+	existing := new(DbfTable)
+	if encodingErr := existing.useFileEncoding(withCodePageFile(fileName, fileEncoding), headerBytes); encodingErr != nil {
+		return nil, encodingErr
+	}
+	if unpackErr := unpackHeader(headerBytes, existing); unpackErr != nil {
+		return nil, unpackErr
+	}
+	existing.dataStore = headerBytes
+
+	// Some writers omit the end-of-file marker, so the records may run to the end of the file.
+	recordsEnd := int64(existing.numberOfBytesInHeader) + int64(existing.numberOfRecords)*int64(existing.lengthOfEachRecord)
+	if info.Size() != recordsEnd {
+		if sizeErr := verifyContentLength(info.Size(), recordsEnd+1); sizeErr != nil {
+			return nil, sizeErr
+		}
+	}
+
+	dt, tableErr := newWriterTable(existing)
+	if tableErr != nil {
+		return nil, tableErr
+	}
+
+	if _, seekErr := f.Seek(recordsEnd, io.SeekStart); seekErr != nil {
+		return nil, seekErr
+	}
+
+	return &Writer{
+		destination:     f,
+		table:           dt,
+		expectedRecords: -1,
+		existingRecords: int(existing.numberOfRecords),
+		file:            f,
+	}, nil
+}
+
+// AppendToFile adds records holding the supplied field values, given in field order, to the end of the existing
+// table file with the given file name, expecting the supplied encoding, as a Writer created by OpenForAppend does.
+// If a record cannot be written, the records before it are kept, and an error is returned.
+func AppendToFile(fileName string, fileEncoding string, records [][]string) error {
+	w, openErr := OpenForAppend(fileName, fileEncoding)
+	if openErr != nil {
+		return openErr
+	}
+
+	for i, values := range records {
+		if writeErr := w.Write(values); writeErr != nil {
+			w.Close()
+			return fmt.Errorf("appending record %d: %w", i, writeErr)
+		}
+	}
+	return w.Close()
+}
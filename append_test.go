@@ -0,0 +1,100 @@
+package godbf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestOpenForAppend_RecordsAddedAfterExisting(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tempFilename := filepath.Join("testdata", "tempAppendTable.dbf")
+	copyValidTestFile(g, tempFilename)
+	defer os.Remove(tempFilename)
+
+	originalTable, _ := NewFromFile(tempFilename, testEncoding)
+	originalRecords := originalTable.NumberOfRecords()
+
+	writerUnderTest, openErr := OpenForAppend(tempFilename, testEncoding)
+	g.Expect(openErr).To(BeNil())
+	g.Expect(writerUnderTest.Write([]string{"T", "appended", "20240229", "7", "1.5"})).To(Succeed())
+	g.Expect(writerUnderTest.Close()).To(Succeed())
+
+	tableUnderTest, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+
+	g.Expect(tableUnderTest.NumberOfRecords()).To(Equal(originalRecords + 1))
+	for row := 0; row < originalRecords; row++ {
+		g.Expect(tableUnderTest.GetRowAsSlice(row)).To(Equal(originalTable.GetRowAsSlice(row)))
+	}
+	g.Expect(tableUnderTest.FieldValueByName(originalRecords, "TESTTEXT")).To(Equal("appended"))
+
+	g.Expect(tableUnderTest.LastUpdated()).To(Equal(tableUnderTest.LowDefTime(time.Now())))
+}
+
+func TestOpenForAppend_Dbase7Autoincrement_ContinuesFromExisting(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schema := NewDbase7(testEncoding)
+	schema.AddAutoincrementField("ID")
+	schema.AddTextField("NAME", 10)
+
+	tempFilename := filepath.Join("testdata", "tempAppendDbase7Table.dbf")
+	file, _ := os.Create(tempFilename)
+	defer os.Remove(tempFilename)
+	initialWriter, _ := NewWriter(file, schema)
+	initialWriter.Write([]string{"", "alice"})
+	g.Expect(initialWriter.Close()).To(Succeed())
+	g.Expect(file.Close()).To(Succeed())
+
+	g.Expect(AppendToFile(tempFilename, testEncoding, [][]string{{"", "bob"}, {"", "carol"}})).To(Succeed())
+
+	tableUnderTest, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(tableUnderTest.GetRowAsSlice(0)).To(Equal([]string{"1", "alice"}))
+	g.Expect(tableUnderTest.GetRowAsSlice(1)).To(Equal([]string{"2", "bob"}))
+	g.Expect(tableUnderTest.GetRowAsSlice(2)).To(Equal([]string{"3", "carol"}))
+}
+
+func TestAppendToFile_InvalidRecord_KeepsEarlierRecords(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tempFilename := filepath.Join("testdata", "tempAppendInvalidTable.dbf")
+	copyValidTestFile(g, tempFilename)
+	defer os.Remove(tempFilename)
+
+	originalTable, _ := NewFromFile(tempFilename, testEncoding)
+
+	appendErr := AppendToFile(tempFilename, testEncoding, [][]string{
+		{"F", "kept", "20240101", "1", "0.5"},
+		{"too few values"},
+	})
+	g.Expect(appendErr).ToNot(BeNil())
+
+	tableUnderTest, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(tableUnderTest.NumberOfRecords()).To(Equal(originalTable.NumberOfRecords() + 1))
+}
+
+func TestOpenForAppend_SizeMismatch_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tempFilename := filepath.Join("testdata", "tempAppendMismatchTable.dbf")
+	rawFileBytes, _ := os.ReadFile(lessThanActualRecordsFile)
+	g.Expect(os.WriteFile(tempFilename, rawFileBytes, 0644)).To(Succeed())
+	defer os.Remove(tempFilename)
+
+	_, openErr := OpenForAppend(tempFilename, testEncoding)
+	g.Expect(openErr).ToNot(BeNil())
+}
+
+func TestOpenForAppend_MissingFile_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, openErr := OpenForAppend(filepath.Join("testdata", "missingFile.dbf"), testEncoding)
+	g.Expect(openErr).ToNot(BeNil())
+}
@@ -0,0 +1,362 @@
+package godbf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Visual FoxPro stores Integer, Currency, Double and DateTime fields as little-endian binary values rather than text.
+// For reference: https://learn.microsoft.com/en-us/previous-versions/visualstudio/foxpro/st4a0s68(v=vs.80)
+//
+// FieldValue and SetFieldValue render and parse these fields as text, as they do for other field types. The typed
+// accessors below avoid the round-trip through text.
+
+const (
+	// currencyScale is the factor a Currency value is multiplied by before storing it as a 64-bit integer.
+	currencyScale         = 10000
+	currencyDecimalPlaces = 4
+
+	// unixEpochJulianDay is the Julian day number of 1970-01-01, the day time.Unix() counts from.
+	unixEpochJulianDay = 2440588
+	millisecondsPerDay = 24 * 60 * 60 * 1000
+
+	// DateTimeFormat is the layout FieldValue renders DateTime fields with, and that SetFieldValue expects of them.
+	DateTimeFormat = "20060102150405"
+)
+
+// binaryFieldValue renders the binary content of a field of the given type as text.
+// DateTime and Timestamp fields that hold no date, and dBase 7 numeric fields that hold no value, are rendered as an
+// empty string.
+func (h *header) binaryFieldValue(fd FieldDescriptor, fieldBytes []byte) string {
+	switch fd.fieldType {
+	case Integer, Autoincrement:
+		if h.isDbase7() && isZeroed(fieldBytes) {
+			return ""
+		}
+		return strconv.FormatInt(int64(h.decodeIntegerField(fieldBytes)), 10)
+	case Currency:
+		return formatCurrency(int64(binary.LittleEndian.Uint64(fieldBytes)))
+	case Double, Level7Double:
+		if fd.fieldType == Level7Double && isZeroed(fieldBytes) {
+			return ""
+		}
+		precision := -1
+		if fd.decimalPlaces > 0 {
+			precision = int(fd.decimalPlaces)
+		}
+		return strconv.FormatFloat(decodeDoubleField(fd, fieldBytes), 'f', precision, 64)
+	case DateTime, Timestamp:
+		dateTime, isSet := decodeDateTimeField(fd, fieldBytes)
+		if !isSet {
+			return ""
+		}
+		return dateTime.Format(DateTimeFormat)
+	default:
+		return ""
+	}
+}
+
+// setBinaryFieldValue parses value as text for a field of the given type, storing its binary encoding in fieldBytes.
+// An empty value stores the type's zero value.
+func (h *header) setBinaryFieldValue(fd FieldDescriptor, fieldBytes []byte, value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		clear(fieldBytes)
+		return nil
+	}
+
+	switch fd.fieldType {
+	case Integer, Autoincrement:
+		parsedValue, parseErr := strconv.ParseInt(value, 10, 32)
+		if parseErr != nil {
+			return &FieldValueError{FieldName: fd.name, FieldType: fd.fieldType, Value: value, Err: parseErr}
+		}
+		h.encodeIntegerField(fieldBytes, int32(parsedValue))
+	case Currency, Double, Level7Double:
+		parsedValue, parseErr := strconv.ParseFloat(value, 64)
+		if parseErr != nil {
+			return &FieldValueError{FieldName: fd.name, FieldType: fd.fieldType, Value: value, Err: parseErr}
+		}
+		if fd.fieldType == Currency {
+			if encodeErr := encodeCurrency(fieldBytes, parsedValue); encodeErr != nil {
+				return &FieldValueError{FieldName: fd.name, FieldType: fd.fieldType, Value: value, Err: encodeErr}
+			}
+			return nil
+		}
+		encodeDoubleField(fd, fieldBytes, parsedValue)
+	case DateTime, Timestamp:
+		layout := DateTimeFormat
+		if len(value) == len(DateFormat) {
+			layout = DateFormat
+		}
+		parsedValue, parseErr := time.Parse(layout, value)
+		if parseErr != nil {
+			return &FieldValueError{FieldName: fd.name, FieldType: fd.fieldType, Value: value, Reason: "expected format " + DateTimeFormat, Err: parseErr}
+		}
+		encodeDateTimeField(fd, fieldBytes, parsedValue)
+	}
+
+	return nil
+}
+
+// decodeIntegerField decodes an Integer or Autoincrement field in the byte order used by the table's level.
+func (h *header) decodeIntegerField(fieldBytes []byte) int32 {
+	if h.isDbase7() {
+		return decodeLong(fieldBytes)
+	}
+	return decodeInteger(fieldBytes)
+}
+
+// encodeIntegerField encodes an Integer or Autoincrement field in the byte order used by the table's level.
+func (h *header) encodeIntegerField(fieldBytes []byte, value int32) {
+	if h.isDbase7() {
+		encodeLong(fieldBytes, value)
+		return
+	}
+	encodeInteger(fieldBytes, value)
+}
+
+func decodeDoubleField(fd FieldDescriptor, fieldBytes []byte) float64 {
+	if fd.fieldType == Level7Double {
+		return decodeLevel7Double(fieldBytes)
+	}
+	return decodeDouble(fieldBytes)
+}
+
+func encodeDoubleField(fd FieldDescriptor, fieldBytes []byte, value float64) {
+	if fd.fieldType == Level7Double {
+		encodeLevel7Double(fieldBytes, value)
+		return
+	}
+	encodeDouble(fieldBytes, value)
+}
+
+func decodeDateTimeField(fd FieldDescriptor, fieldBytes []byte) (time.Time, bool) {
+	if fd.fieldType == Timestamp {
+		return decodeTimestamp(fieldBytes)
+	}
+	return decodeDateTime(fieldBytes)
+}
+
+func encodeDateTimeField(fd FieldDescriptor, fieldBytes []byte, value time.Time) {
+	if fd.fieldType == Timestamp {
+		encodeTimestamp(fieldBytes, value)
+		return
+	}
+	encodeDateTime(fieldBytes, value)
+}
+
+func decodeInteger(fieldBytes []byte) int32 {
+	return int32(binary.LittleEndian.Uint32(fieldBytes))
+}
+
+func encodeInteger(fieldBytes []byte, value int32) {
+	binary.LittleEndian.PutUint32(fieldBytes, uint32(value))
+}
+
+func decodeDouble(fieldBytes []byte) float64 {
+	return math.Float64frombits(binary.LittleEndian.Uint64(fieldBytes))
+}
+
+func encodeDouble(fieldBytes []byte, value float64) {
+	binary.LittleEndian.PutUint64(fieldBytes, math.Float64bits(value))
+}
+
+func decodeCurrency(fieldBytes []byte) float64 {
+	return float64(int64(binary.LittleEndian.Uint64(fieldBytes))) / currencyScale
+}
+
+func encodeCurrency(fieldBytes []byte, value float64) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return errors.New("it is not a finite number")
+	}
+	scaledValue := math.Round(value * currencyScale)
+	if scaledValue >= math.MaxInt64 || scaledValue < math.MinInt64 {
+		return errors.New("it is too large to store as Currency")
+	}
+	binary.LittleEndian.PutUint64(fieldBytes, uint64(int64(scaledValue)))
+	return nil
+}
+
+// formatCurrency renders a scaled Currency value with its four decimal places, without a float64 round-trip.
+func formatCurrency(scaledValue int64) string {
+	sign := ""
+	magnitude := uint64(scaledValue)
+	if scaledValue < 0 {
+		sign = "-"
+		magnitude = uint64(-scaledValue)
+	}
+	return fmt.Sprintf("%s%d.%0*d", sign, magnitude/currencyScale, currencyDecimalPlaces, magnitude%currencyScale)
+}
+
+// decodeDateTime interprets a DateTime field's Julian day number and milliseconds since midnight as a time.Time in
+// UTC. isSet is false if the field holds no date.
+func decodeDateTime(fieldBytes []byte) (value time.Time, isSet bool) {
+	julianDay := int64(int32(binary.LittleEndian.Uint32(fieldBytes[0:4])))
+	milliseconds := int64(int32(binary.LittleEndian.Uint32(fieldBytes[4:8])))
+	if julianDay == 0 {
+		return time.Time{}, false
+	}
+
+	unixMilliseconds := (julianDay-unixEpochJulianDay)*millisecondsPerDay + milliseconds
+	return time.UnixMilli(unixMilliseconds).UTC(), true
+}
+
+// encodeDateTime stores the wall-clock date and time of value, ignoring its location, as a DateTime field's Julian
+// day number and milliseconds since midnight. A zero value clears the field.
+func encodeDateTime(fieldBytes []byte, value time.Time) {
+	if value.IsZero() {
+		clear(fieldBytes)
+		return
+	}
+
+	wallClock := time.Date(value.Year(), value.Month(), value.Day(), value.Hour(), value.Minute(), value.Second(), value.Nanosecond(), time.UTC)
+	unixMilliseconds := wallClock.UnixMilli()
+
+	days := unixMilliseconds / millisecondsPerDay
+	milliseconds := unixMilliseconds % millisecondsPerDay
+	if milliseconds < 0 {
+		days--
+		milliseconds += millisecondsPerDay
+	}
+
+	binary.LittleEndian.PutUint32(fieldBytes[0:4], uint32(int32(days+unixEpochJulianDay)))
+	binary.LittleEndian.PutUint32(fieldBytes[4:8], uint32(int32(milliseconds)))
+}
+
+// typedFieldIndex returns the index of the field with the given name, if it is of one of the expected types.
+// If the field does not exist, or is of another type, an error is returned.
+func (dt *DbfTable) typedFieldIndex(fieldName string, expectedTypes ...DbaseDataType) (int, error) {
+	fieldIndex, found := dt.fieldMap[fieldName]
+	if !found {
+		return 0, &FieldNotFoundError{FieldName: fieldName}
+	}
+	if !slices.Contains(expectedTypes, dt.fields[fieldIndex].fieldType) {
+		typeNames := make([]string, len(expectedTypes))
+		for i, expectedType := range expectedTypes {
+			typeNames[i] = expectedType.name()
+		}
+		return 0, errors.New("type of field \"" + fieldName + "\" is not " + strings.Join(typeNames, " or "))
+	}
+	return fieldIndex, nil
+}
+
+// typedFieldBytes returns the index of the field with the given name, and its content at the given row, if it is of
+// one of the expected types.
+// If the row or field does not exist, or the field is of another type, an error is returned.
+func (dt *DbfTable) typedFieldBytes(row int, fieldName string, expectedTypes ...DbaseDataType) (int, []byte, error) {
+	fieldIndex, err := dt.typedFieldIndex(fieldName, expectedTypes...)
+	if err != nil {
+		return 0, nil, err
+	}
+	if row < 0 || !dt.HasRecord(row) {
+		return 0, nil, fmt.Errorf("row %d does not exist: %w", row, ErrRowOutOfBounds)
+	}
+	fieldBytes, bytesErr := dt.fieldBytes(row, fieldIndex)
+	if bytesErr != nil {
+		return 0, nil, bytesErr
+	}
+	return fieldIndex, fieldBytes, nil
+}
+
+// setTypedFieldValue encodes a value into the field with the given name at the given row, if it is of one of the
+// expected types, and marks the field as not holding null, as SetFieldValue does.
+// If the table is read only, the row or field does not exist, the field is of another type, or the value cannot be
+// encoded, an error is returned.
+func (dt *DbfTable) setTypedFieldValue(row int, fieldName string, encode func(field FieldDescriptor, fieldBytes []byte) error, expectedTypes ...DbaseDataType) error {
+	if writableErr := dt.verifyWritable(); writableErr != nil {
+		return writableErr
+	}
+	fieldIndex, fieldBytes, err := dt.typedFieldBytes(row, fieldName, expectedTypes...)
+	if err != nil {
+		return err
+	}
+	if encodeErr := encode(dt.fields[fieldIndex], fieldBytes); encodeErr != nil {
+		return encodeErr
+	}
+	dt.clearNull(row, fieldIndex)
+	return nil
+}
+
+// IntegerFieldValueByName returns the value of an Integer or Autoincrement field given row number and name provided.
+func (dt *DbfTable) IntegerFieldValueByName(row int, fieldName string) (value int32, err error) {
+	_, fieldBytes, err := dt.typedFieldBytes(row, fieldName, Integer, Autoincrement)
+	if err != nil {
+		return 0, err
+	}
+	return dt.decodeIntegerField(fieldBytes), nil
+}
+
+// SetIntegerFieldValueByName sets the value of an Integer or Autoincrement field for the given row and field name as
+// specified.
+func (dt *DbfTable) SetIntegerFieldValueByName(row int, fieldName string, value int32) (err error) {
+	return dt.setTypedFieldValue(row, fieldName, func(_ FieldDescriptor, fieldBytes []byte) error {
+		dt.encodeIntegerField(fieldBytes, value)
+		return nil
+	}, Integer, Autoincrement)
+}
+
+// CurrencyFieldValueByName returns the value of a Currency field given row number and name provided.
+func (dt *DbfTable) CurrencyFieldValueByName(row int, fieldName string) (value float64, err error) {
+	_, fieldBytes, err := dt.typedFieldBytes(row, fieldName, Currency)
+	if err != nil {
+		return 0, err
+	}
+	return decodeCurrency(fieldBytes), nil
+}
+
+// SetCurrencyFieldValueByName sets the value of a Currency field for the given row and field name as specified.
+// The value is rounded to the four decimal places a Currency field stores. NaN, infinite values and values too large
+// to store return a FieldValueError.
+func (dt *DbfTable) SetCurrencyFieldValueByName(row int, fieldName string, value float64) (err error) {
+	return dt.setTypedFieldValue(row, fieldName, func(fd FieldDescriptor, fieldBytes []byte) error {
+		if encodeErr := encodeCurrency(fieldBytes, value); encodeErr != nil {
+			return &FieldValueError{FieldName: fd.name, FieldType: fd.fieldType, Value: strconv.FormatFloat(value, 'g', -1, 64), Err: encodeErr}
+		}
+		return nil
+	}, Currency)
+}
+
+// DoubleFieldValueByName returns the value of a Double field given row number and name provided.
+func (dt *DbfTable) DoubleFieldValueByName(row int, fieldName string) (value float64, err error) {
+	fieldIndex, fieldBytes, err := dt.typedFieldBytes(row, fieldName, dt.doubleType())
+	if err != nil {
+		return 0, err
+	}
+	return decodeDoubleField(dt.fields[fieldIndex], fieldBytes), nil
+}
+
+// SetDoubleFieldValueByName sets the value of a Double field for the given row and field name as specified.
+func (dt *DbfTable) SetDoubleFieldValueByName(row int, fieldName string, value float64) (err error) {
+	return dt.setTypedFieldValue(row, fieldName, func(field FieldDescriptor, fieldBytes []byte) error {
+		encodeDoubleField(field, fieldBytes, value)
+		return nil
+	}, dt.doubleType())
+}
+
+// DateTimeFieldValueByName returns the value of a DateTime (or dBase 7 Timestamp) field given row number and name
+// provided, in UTC. A field holding no date returns the zero time.Time.
+func (dt *DbfTable) DateTimeFieldValueByName(row int, fieldName string) (value time.Time, err error) {
+	fieldIndex, fieldBytes, err := dt.typedFieldBytes(row, fieldName, dt.dateTimeType())
+	if err != nil {
+		return time.Time{}, err
+	}
+	value, _ = decodeDateTimeField(dt.fields[fieldIndex], fieldBytes)
+	return value, nil
+}
+
+// SetDateTimeFieldValueByName sets the value of a DateTime (or dBase 7 Timestamp) field for the given row and field
+// name as specified. The wall-clock date and time of value is stored, ignoring its location, to millisecond precision.
+// A zero value clears the field.
+func (dt *DbfTable) SetDateTimeFieldValueByName(row int, fieldName string, value time.Time) (err error) {
+	return dt.setTypedFieldValue(row, fieldName, func(field FieldDescriptor, fieldBytes []byte) error {
+		encodeDateTimeField(field, fieldBytes, value)
+		return nil
+	}, dt.dateTimeType())
+}
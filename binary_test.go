@@ -0,0 +1,254 @@
+package godbf
+
+import (
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDbfTable_AddBinaryFields(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	g.Expect(tableUnderTest.AddIntegerField("intField")).To(Succeed())
+	g.Expect(tableUnderTest.AddCurrencyField("curField")).To(Succeed())
+	g.Expect(tableUnderTest.AddDoubleField("dblField", 3)).To(Succeed())
+	g.Expect(tableUnderTest.AddDateTimeField("dtField")).To(Succeed())
+
+	fields := tableUnderTest.Fields()
+	g.Expect(fields[0].FieldType()).To(Equal(Integer))
+	g.Expect(fields[0].Length()).To(BeNumerically("==", 4))
+	g.Expect(fields[1].FieldType()).To(Equal(Currency))
+	g.Expect(fields[1].Length()).To(BeNumerically("==", 8))
+	g.Expect(fields[1].DecimalPlaces()).To(BeNumerically("==", 4))
+	g.Expect(fields[2].FieldType()).To(Equal(Double))
+	g.Expect(fields[2].Length()).To(BeNumerically("==", 8))
+	g.Expect(fields[2].DecimalPlaces()).To(BeNumerically("==", 3))
+	g.Expect(fields[3].FieldType()).To(Equal(DateTime))
+	g.Expect(fields[3].Length()).To(BeNumerically("==", 8))
+
+	g.Expect(tableUnderTest.lengthOfEachRecord).To(BeNumerically("==", 1+4+8+8+8))
+}
+
+func TestDbfTable_IntegerFieldValueByName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	const intFieldName = "intField"
+	tableUnderTest.AddIntegerField(intFieldName)
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+
+	expectedValue := int32(-123456)
+	g.Expect(tableUnderTest.SetIntegerFieldValueByName(recordIndex, intFieldName, expectedValue)).To(Succeed())
+
+	g.Expect(tableUnderTest.fieldBytes(recordIndex, 0)).To(Equal([]byte{0xC0, 0x1D, 0xFE, 0xFF}))
+	g.Expect(tableUnderTest.IntegerFieldValueByName(recordIndex, intFieldName)).To(Equal(expectedValue))
+	g.Expect(tableUnderTest.FieldValueByName(recordIndex, intFieldName)).To(Equal("-123456"))
+	g.Expect(tableUnderTest.Int64FieldValueByName(recordIndex, intFieldName)).To(BeNumerically("==", expectedValue))
+}
+
+func TestDbfTable_CurrencyFieldValueByName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	const curFieldName = "curField"
+	tableUnderTest.AddCurrencyField(curFieldName)
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+
+	g.Expect(tableUnderTest.SetCurrencyFieldValueByName(recordIndex, curFieldName, -12.34)).To(Succeed())
+
+	g.Expect(tableUnderTest.fieldBytes(recordIndex, 0)).To(Equal([]byte{0xF8, 0x1D, 0xFE, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}))
+	g.Expect(tableUnderTest.CurrencyFieldValueByName(recordIndex, curFieldName)).To(Equal(-12.34))
+	g.Expect(tableUnderTest.FieldValueByName(recordIndex, curFieldName)).To(Equal("-12.3400"))
+}
+
+func TestDbfTable_SetCurrencyFieldValue_NotFinite_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	const curFieldName = "curField"
+	tableUnderTest.AddCurrencyField(curFieldName)
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+	g.Expect(tableUnderTest.SetCurrencyFieldValueByName(recordIndex, curFieldName, 1.5)).To(Succeed())
+
+	for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		setErr := tableUnderTest.SetCurrencyFieldValueByName(recordIndex, curFieldName, value)
+		var valueErr *FieldValueError
+		g.Expect(errors.As(setErr, &valueErr)).To(BeTrue())
+		g.Expect(errors.Is(setErr, ErrInvalidFieldValue)).To(BeTrue())
+	}
+	for _, value := range []string{"NaN", "+Inf", "-Inf"} {
+		setErr := tableUnderTest.SetFieldValue(recordIndex, 0, value)
+		var valueErr *FieldValueError
+		g.Expect(errors.As(setErr, &valueErr)).To(BeTrue())
+		g.Expect(valueErr.Value).To(Equal(value))
+	}
+
+	g.Expect(tableUnderTest.CurrencyFieldValueByName(recordIndex, curFieldName)).To(Equal(1.5))
+}
+
+func TestDbfTable_DoubleFieldValueByName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	const dblFieldName = "dblField"
+	tableUnderTest.AddDoubleField(dblFieldName, 2)
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+
+	expectedValue := 3.14159
+	g.Expect(tableUnderTest.SetDoubleFieldValueByName(recordIndex, dblFieldName, expectedValue)).To(Succeed())
+
+	g.Expect(tableUnderTest.DoubleFieldValueByName(recordIndex, dblFieldName)).To(Equal(expectedValue))
+	g.Expect(tableUnderTest.FieldValueByName(recordIndex, dblFieldName)).To(Equal("3.14"))
+}
+
+func TestDbfTable_DateTimeFieldValueByName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	const dtFieldName = "dtField"
+	tableUnderTest.AddDateTimeField(dtFieldName)
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+	emptyRecordIndex, _ := tableUnderTest.AddNewRecord()
+
+	expectedValue := time.Date(2019, time.March, 4, 13, 14, 15, 500*int(time.Millisecond), time.UTC)
+	g.Expect(tableUnderTest.SetDateTimeFieldValueByName(recordIndex, dtFieldName, expectedValue)).To(Succeed())
+
+	g.Expect(tableUnderTest.DateTimeFieldValueByName(recordIndex, dtFieldName)).To(Equal(expectedValue))
+	g.Expect(tableUnderTest.FieldValueByName(recordIndex, dtFieldName)).To(Equal("20190304131415"))
+
+	g.Expect(tableUnderTest.DateTimeFieldValueByName(emptyRecordIndex, dtFieldName)).To(Equal(time.Time{}))
+	g.Expect(tableUnderTest.FieldValueByName(emptyRecordIndex, dtFieldName)).To(Equal(""))
+}
+
+func TestDbfTable_DateTimeFieldValueByName_JulianDayEncoding(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	fieldBytes := make([]byte, 8)
+	encodeDateTime(fieldBytes, time.Date(2000, time.January, 1, 0, 0, 1, 0, time.UTC))
+
+	// 2000-01-01 is Julian day 2451545 (0x00256859), one second after midnight is 1000 milliseconds (0x03E8).
+	g.Expect(fieldBytes).To(Equal([]byte{0x59, 0x68, 0x25, 0x00, 0xE8, 0x03, 0x00, 0x00}))
+}
+
+func TestDbfTable_SetFieldValue_BinaryFieldsFromText(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	tableUnderTest.AddIntegerField("intField")
+	tableUnderTest.AddCurrencyField("curField")
+	tableUnderTest.AddDoubleField("dblField", 0)
+	tableUnderTest.AddDateTimeField("dtField")
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+
+	g.Expect(tableUnderTest.SetFieldValueByName(recordIndex, "intField", "42")).To(Succeed())
+	g.Expect(tableUnderTest.SetFieldValueByName(recordIndex, "curField", "1234.5678")).To(Succeed())
+	g.Expect(tableUnderTest.SetFieldValueByName(recordIndex, "dblField", "0.25")).To(Succeed())
+	g.Expect(tableUnderTest.SetFieldValueByName(recordIndex, "dtField", "20181201")).To(Succeed())
+
+	g.Expect(tableUnderTest.GetRowAsSlice(recordIndex)).To(Equal([]string{"42", "1234.5678", "0.25", "20181201000000"}))
+}
+
+func TestDbfTable_SetFieldValue_InvalidBinaryText_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	tableUnderTest.AddIntegerField("intField")
+	tableUnderTest.AddDateTimeField("dtField")
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+
+	intError := tableUnderTest.SetFieldValueByName(recordIndex, "intField", "4294967296")
+	g.Expect(intError).ToNot(BeNil())
+	t.Log(intError)
+
+	dateTimeError := tableUnderTest.SetFieldValueByName(recordIndex, "dtField", "yesterday")
+	g.Expect(dateTimeError).ToNot(BeNil())
+	t.Log(dateTimeError)
+}
+
+func TestDbfTable_IntegerFieldValueByName_WrongFieldType_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	tableUnderTest.AddNumberField("numField", 10, 0)
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+
+	_, getError := tableUnderTest.IntegerFieldValueByName(recordIndex, "numField")
+	g.Expect(getError).ToNot(BeNil())
+	t.Log(getError)
+
+	_, missingError := tableUnderTest.IntegerFieldValueByName(recordIndex, "missingField")
+	g.Expect(missingError).ToNot(BeNil())
+}
+
+func TestSaveToFile_WithBinaryFields_LoadOfSavedIsCorrect(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableToSave := New(testEncoding)
+	tableToSave.AddIntegerField("INTFIELD")
+	tableToSave.AddDoubleField("DBLFIELD", 1)
+	recordIndex, _ := tableToSave.AddNewRecord()
+	tableToSave.SetIntegerFieldValueByName(recordIndex, "INTFIELD", 7)
+	tableToSave.SetDoubleFieldValueByName(recordIndex, "DBLFIELD", 2.5)
+
+	tempFilename := filepath.Join("testdata", "tempSavedBinaryTable.dbf")
+	g.Expect(SaveToFile(tableToSave, tempFilename)).To(Succeed())
+
+	tableUnderTest, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+
+	g.Expect(tableUnderTest.Fields()[1].DecimalPlaces()).To(BeNumerically("==", 1))
+	g.Expect(tableUnderTest.IntegerFieldValueByName(recordIndex, "INTFIELD")).To(BeNumerically("==", 7))
+	g.Expect(tableUnderTest.DoubleFieldValueByName(recordIndex, "DBLFIELD")).To(Equal(2.5))
+
+	g.Expect(os.Remove(tempFilename)).To(Succeed())
+}
+
+func TestDbfTable_SetIntegerFieldValueByName_NullField_NullCleared(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableBytes := visualFoxProTestBytes()
+	tableBytes[32+32+fieldFlagsOffset] = nullableFieldFlag
+	tableUnderTest, _ := NewFromByteArray(tableBytes, testEncoding)
+
+	g.Expect(tableUnderTest.SetNullByName(0, "AMOUNT")).To(Succeed())
+	g.Expect(tableUnderTest.IsNullByName(0, "AMOUNT")).To(BeTrue())
+
+	g.Expect(tableUnderTest.SetIntegerFieldValueByName(0, "AMOUNT", 5)).To(Succeed())
+	g.Expect(tableUnderTest.IsNullByName(0, "AMOUNT")).To(BeFalse())
+	g.Expect(tableUnderTest.FieldValueByName(0, "AMOUNT")).To(Equal("5"))
+}
+
+func TestDbfTable_TypedFieldValueByName_RowOutOfRange_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	g.Expect(tableUnderTest.AddIntegerField("intField")).To(Succeed())
+	g.Expect(tableUnderTest.AddCurrencyField("curField")).To(Succeed())
+	g.Expect(tableUnderTest.AddDoubleField("dblField", 3)).To(Succeed())
+	g.Expect(tableUnderTest.AddDateTimeField("dtField")).To(Succeed())
+	tableUnderTest.AddNewRecord()
+
+	for _, row := range []int{-1, 1} {
+		_, intErr := tableUnderTest.IntegerFieldValueByName(row, "intField")
+		g.Expect(errors.Is(intErr, ErrRowOutOfBounds)).To(BeTrue())
+		g.Expect(errors.Is(tableUnderTest.SetIntegerFieldValueByName(row, "intField", 1), ErrRowOutOfBounds)).To(BeTrue())
+
+		_, curErr := tableUnderTest.CurrencyFieldValueByName(row, "curField")
+		g.Expect(errors.Is(curErr, ErrRowOutOfBounds)).To(BeTrue())
+		g.Expect(errors.Is(tableUnderTest.SetCurrencyFieldValueByName(row, "curField", 1), ErrRowOutOfBounds)).To(BeTrue())
+
+		_, dblErr := tableUnderTest.DoubleFieldValueByName(row, "dblField")
+		g.Expect(errors.Is(dblErr, ErrRowOutOfBounds)).To(BeTrue())
+		g.Expect(errors.Is(tableUnderTest.SetDoubleFieldValueByName(row, "dblField", 1), ErrRowOutOfBounds)).To(BeTrue())
+
+		_, dtErr := tableUnderTest.DateTimeFieldValueByName(row, "dtField")
+		g.Expect(errors.Is(dtErr, ErrRowOutOfBounds)).To(BeTrue())
+		g.Expect(errors.Is(tableUnderTest.SetDateTimeFieldValueByName(row, "dtField", time.Now()), ErrRowOutOfBounds)).To(BeTrue())
+	}
+}
@@ -0,0 +1,219 @@
+package godbf
+
+import (
+	"slices"
+	"strconv"
+)
+
+// LanguageDriverEntry describes a documented language driver ID: the code page it identifies, the name of the
+// encoding used for that code page, or "" if the code page has no supported encoding, and what the ID is documented
+// as.
+type LanguageDriverEntry struct {
+	ID          LanguageDriver
+	CodePage    int
+	Encoding    string
+	Description string
+}
+
+// languageDrivers holds the language driver IDs documented for dBase, FoxPro and Clipper, in ID order.
+var languageDrivers = newLanguageDriverRegistry(
+	map[int]string{
+		437:   "IBM437",
+		620:   "", // Mazovia
+		737:   "IBM737",
+		850:   "IBM850",
+		852:   "IBM852",
+		857:   "ibm-857_P100-1995",
+		860:   "ibm-860_P100-1995",
+		861:   "ibm-861_P100-1995",
+		863:   "ibm-863_P100-1995",
+		865:   "ibm-865_P100-1995",
+		866:   "IBM866",
+		874:   "windows-874",
+		895:   "", // Kamenicky
+		932:   "Shift_JIS",
+		936:   "GBK",
+		949:   "EUC-KR",
+		950:   "Big5",
+		1250:  "windows-1250",
+		1251:  "windows-1251",
+		1252:  "windows-1252",
+		1253:  "windows-1253",
+		1254:  "windows-1254",
+		1255:  "windows-1255",
+		1256:  "windows-1256",
+		1257:  "windows-1257",
+		10000: "macos-0_2-10.2",
+		10006: "macos-6_2-10.4",
+		10007: "macos-7_3-10.2",
+		10029: "macos-29-10.2",
+		65001: "UTF-8",
+	},
+	LanguageDriverEntry{ID: 0x01, CodePage: 437, Description: "DOS USA"},
+	LanguageDriverEntry{ID: 0x02, CodePage: 850, Description: "DOS Multilingual"},
+	LanguageDriverEntry{ID: 0x03, CodePage: 1252, Description: "Windows ANSI"},
+	LanguageDriverEntry{ID: 0x04, CodePage: 10000, Description: "Standard Macintosh"},
+	LanguageDriverEntry{ID: 0x08, CodePage: 865, Description: "Danish OEM"},
+	LanguageDriverEntry{ID: 0x09, CodePage: 437, Description: "Dutch OEM"},
+	LanguageDriverEntry{ID: 0x0A, CodePage: 850, Description: "Dutch OEM secondary"},
+	LanguageDriverEntry{ID: 0x0B, CodePage: 437, Description: "Finnish OEM"},
+	LanguageDriverEntry{ID: 0x0D, CodePage: 437, Description: "French OEM"},
+	LanguageDriverEntry{ID: 0x0E, CodePage: 850, Description: "French OEM secondary"},
+	LanguageDriverEntry{ID: 0x0F, CodePage: 437, Description: "German OEM"},
+	LanguageDriverEntry{ID: 0x10, CodePage: 850, Description: "German OEM secondary"},
+	LanguageDriverEntry{ID: 0x11, CodePage: 437, Description: "Italian OEM"},
+	LanguageDriverEntry{ID: 0x12, CodePage: 850, Description: "Italian OEM secondary"},
+	LanguageDriverEntry{ID: 0x13, CodePage: 932, Description: "Japanese Shift-JIS"},
+	LanguageDriverEntry{ID: 0x14, CodePage: 850, Description: "Spanish OEM secondary"},
+	LanguageDriverEntry{ID: 0x15, CodePage: 437, Description: "Swedish OEM"},
+	LanguageDriverEntry{ID: 0x16, CodePage: 850, Description: "Swedish OEM secondary"},
+	LanguageDriverEntry{ID: 0x17, CodePage: 865, Description: "Norwegian OEM"},
+	LanguageDriverEntry{ID: 0x18, CodePage: 437, Description: "Spanish OEM"},
+	LanguageDriverEntry{ID: 0x19, CodePage: 437, Description: "English OEM (Britain)"},
+	LanguageDriverEntry{ID: 0x1A, CodePage: 850, Description: "English OEM (Britain) secondary"},
+	LanguageDriverEntry{ID: 0x1B, CodePage: 437, Description: "English OEM (U.S.)"},
+	LanguageDriverEntry{ID: 0x1C, CodePage: 863, Description: "French OEM (Canada)"},
+	LanguageDriverEntry{ID: 0x1D, CodePage: 850, Description: "French OEM secondary"},
+	LanguageDriverEntry{ID: 0x1F, CodePage: 852, Description: "Czech OEM"},
+	LanguageDriverEntry{ID: 0x22, CodePage: 852, Description: "Hungarian OEM"},
+	LanguageDriverEntry{ID: 0x23, CodePage: 852, Description: "Polish OEM"},
+	LanguageDriverEntry{ID: 0x24, CodePage: 860, Description: "Portuguese OEM"},
+	LanguageDriverEntry{ID: 0x25, CodePage: 850, Description: "Portuguese OEM secondary"},
+	LanguageDriverEntry{ID: 0x26, CodePage: 866, Description: "Russian OEM"},
+	LanguageDriverEntry{ID: 0x37, CodePage: 850, Description: "English OEM (U.S.) secondary"},
+	LanguageDriverEntry{ID: 0x40, CodePage: 852, Description: "Romanian OEM"},
+	LanguageDriverEntry{ID: 0x4D, CodePage: 936, Description: "Chinese GBK (PRC)"},
+	LanguageDriverEntry{ID: 0x4E, CodePage: 949, Description: "Korean (ANSI/OEM)"},
+	LanguageDriverEntry{ID: 0x4F, CodePage: 950, Description: "Chinese Big5 (Taiwan)"},
+	LanguageDriverEntry{ID: 0x50, CodePage: 874, Description: "Thai (ANSI/OEM)"},
+	LanguageDriverEntry{ID: 0x57, CodePage: 1252, Description: "ANSI"},
+	LanguageDriverEntry{ID: 0x58, CodePage: 1252, Description: "Western European ANSI"},
+	LanguageDriverEntry{ID: 0x59, CodePage: 1252, Description: "Spanish ANSI"},
+	LanguageDriverEntry{ID: 0x64, CodePage: 852, Description: "Eastern European MS-DOS"},
+	LanguageDriverEntry{ID: 0x65, CodePage: 866, Description: "Russian MS-DOS"},
+	LanguageDriverEntry{ID: 0x66, CodePage: 865, Description: "Nordic MS-DOS"},
+	LanguageDriverEntry{ID: 0x67, CodePage: 861, Description: "Icelandic MS-DOS"},
+	LanguageDriverEntry{ID: 0x68, CodePage: 895, Description: "Kamenicky (Czech) MS-DOS"},
+	LanguageDriverEntry{ID: 0x69, CodePage: 620, Description: "Mazovia (Polish) MS-DOS"},
+	LanguageDriverEntry{ID: 0x6A, CodePage: 737, Description: "Greek MS-DOS (437G)"},
+	LanguageDriverEntry{ID: 0x6B, CodePage: 857, Description: "Turkish MS-DOS"},
+	LanguageDriverEntry{ID: 0x6C, CodePage: 863, Description: "French-Canadian MS-DOS"},
+	LanguageDriverEntry{ID: 0x78, CodePage: 950, Description: "Taiwan Big5"},
+	LanguageDriverEntry{ID: 0x79, CodePage: 949, Description: "Hangul (Wansung)"},
+	LanguageDriverEntry{ID: 0x7A, CodePage: 936, Description: "PRC GBK"},
+	LanguageDriverEntry{ID: 0x7B, CodePage: 932, Description: "Japanese Shift-JIS"},
+	LanguageDriverEntry{ID: 0x7C, CodePage: 874, Description: "Thai Windows/MS-DOS"},
+	LanguageDriverEntry{ID: 0x7D, CodePage: 1255, Description: "Hebrew Windows"},
+	LanguageDriverEntry{ID: 0x7E, CodePage: 1256, Description: "Arabic Windows"},
+	LanguageDriverEntry{ID: 0x86, CodePage: 737, Description: "Greek OEM"},
+	LanguageDriverEntry{ID: 0x87, CodePage: 852, Description: "Slovenian OEM"},
+	LanguageDriverEntry{ID: 0x88, CodePage: 857, Description: "Turkish OEM"},
+	LanguageDriverEntry{ID: 0x96, CodePage: 10007, Description: "Russian Macintosh"},
+	LanguageDriverEntry{ID: 0x97, CodePage: 10029, Description: "Eastern European Macintosh"},
+	LanguageDriverEntry{ID: 0x98, CodePage: 10006, Description: "Greek Macintosh"},
+	LanguageDriverEntry{ID: 0xC8, CodePage: 1250, Description: "Eastern European Windows"},
+	LanguageDriverEntry{ID: 0xC9, CodePage: 1251, Description: "Russian Windows"},
+	LanguageDriverEntry{ID: 0xCA, CodePage: 1254, Description: "Turkish Windows"},
+	LanguageDriverEntry{ID: 0xCB, CodePage: 1253, Description: "Greek Windows"},
+	LanguageDriverEntry{ID: 0xCC, CodePage: 1257, Description: "Baltic Windows"},
+)
+
+// languageDriverRegistry maps language driver IDs, code pages and encoding names to each other. Several IDs may
+// identify the same code page, in which case the first registered is the one a code page, or its encoding, maps to.
+type languageDriverRegistry struct {
+	entries           []LanguageDriverEntry
+	byID              map[LanguageDriver]LanguageDriverEntry
+	byCodePage        map[int]LanguageDriver
+	byEncoding        map[string]LanguageDriver
+	codePageEncodings map[int]string
+}
+
+// newLanguageDriverRegistry creates a registry of the given entries, filling in their encodings from the encoding
+// names of the given code pages.
+func newLanguageDriverRegistry(codePageEncodings map[int]string, entries ...LanguageDriverEntry) *languageDriverRegistry {
+	registry := &languageDriverRegistry{
+		byID:              make(map[LanguageDriver]LanguageDriverEntry),
+		byCodePage:        make(map[int]LanguageDriver),
+		byEncoding:        make(map[string]LanguageDriver),
+		codePageEncodings: codePageEncodings,
+	}
+
+	for _, entry := range entries {
+		entry.Encoding = codePageEncodings[entry.CodePage]
+		registry.entries = append(registry.entries, entry)
+		registry.byID[entry.ID] = entry
+
+		if _, found := registry.byCodePage[entry.CodePage]; !found {
+			registry.byCodePage[entry.CodePage] = entry.ID
+		}
+		if _, found := registry.byEncoding[entry.Encoding]; !found && entry.Encoding != "" {
+			registry.byEncoding[entry.Encoding] = entry.ID
+		}
+	}
+	return registry
+}
+
+// LanguageDrivers returns the language driver IDs documented for dBase, FoxPro and Clipper, in ID order.
+func LanguageDrivers() []LanguageDriverEntry {
+	return slices.Clone(languageDrivers.entries)
+}
+
+// LanguageDriverForCodePage returns the language driver ID to record for text of the given code page, or false if no
+// documented ID identifies it.
+func LanguageDriverForCodePage(codePage int) (LanguageDriver, bool) {
+	languageDriver, found := languageDrivers.byCodePage[codePage]
+	return languageDriver, found
+}
+
+// LanguageDriverForEncoding returns the language driver ID to record for text of the given encoding, or false if no
+// documented ID identifies its code page. The encoding may be named by any of its aliases, or its code page number.
+func LanguageDriverForEncoding(encoding string) (LanguageDriver, bool) {
+	languageDriver, found := languageDrivers.byEncoding[canonicalEncoding(encoding)]
+	return languageDriver, found
+}
+
+// EncodingForCodePage returns the name of the encoding of the given code page, or false if it has no supported
+// encoding.
+func EncodingForCodePage(codePage int) (string, bool) {
+	if encoding, found := languageDrivers.codePageEncodings[codePage]; found {
+		return encoding, encoding != ""
+	}
+	encoding, found := lookup[strconv.Itoa(codePage)]
+	return encoding, found
+}
+
+// CodePageForEncoding returns the code page of the given encoding, or false if it has none a language driver ID
+// identifies. The encoding may be named by any of its aliases, or its code page number.
+func CodePageForEncoding(encoding string) (int, bool) {
+	languageDriver, found := LanguageDriverForEncoding(encoding)
+	return languageDriver.CodePage(), found
+}
+
+// canonicalEncoding returns the name the registry knows the given encoding by, resolving its aliases.
+func canonicalEncoding(encoding string) string {
+	if canonical, found := lookup[encoding]; found {
+		return canonical
+	}
+	if codePage, numberErr := strconv.Atoi(encoding); numberErr == nil {
+		if canonical, found := EncodingForCodePage(codePage); found {
+			return canonical
+		}
+	}
+	return encoding
+}
+
+// CodePage returns the code page identified by the language driver ID, or 0 if the ID is not a documented one.
+func (ld LanguageDriver) CodePage() int {
+	return languageDrivers.byID[ld].CodePage
+}
+
+// Encoding returns the name of the encoding of the code page identified by the language driver ID, or "" if the ID
+// is not a documented one, or its code page has no supported encoding.
+func (ld LanguageDriver) Encoding() string {
+	return languageDrivers.byID[ld].Encoding
+}
+
+// Description returns what the language driver ID is documented as, or "" if it is not a documented one.
+func (ld LanguageDriver) Description() string {
+	return languageDrivers.byID[ld].Description
+}
@@ -0,0 +1,82 @@
+package godbf
+
+import (
+	. "github.com/onsi/gomega"
+	"testing"
+)
+
+func TestLanguageDrivers_EveryID_RoundTrips(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	entries := LanguageDrivers()
+	g.Expect(entries).To(HaveLen(67))
+
+	for _, entry := range entries {
+		g.Expect(entry.ID.CodePage()).To(Equal(entry.CodePage), entry.Description)
+		g.Expect(entry.ID.Encoding()).To(Equal(entry.Encoding), entry.Description)
+		g.Expect(entry.ID.Description()).To(Equal(entry.Description))
+
+		byCodePage, found := LanguageDriverForCodePage(entry.CodePage)
+		g.Expect(found).To(BeTrue(), entry.Description)
+		g.Expect(byCodePage.CodePage()).To(Equal(entry.CodePage), entry.Description)
+
+		if entry.Encoding == "" {
+			_, found = EncodingForCodePage(entry.CodePage)
+			g.Expect(found).To(BeFalse(), entry.Description)
+			continue
+		}
+
+		g.Expect(encodingNamed(entry.Encoding)).NotTo(BeNil(), entry.Encoding)
+
+		encoding, found := EncodingForCodePage(entry.CodePage)
+		g.Expect(found).To(BeTrue(), entry.Description)
+		g.Expect(encoding).To(Equal(entry.Encoding))
+
+		byEncoding, found := LanguageDriverForEncoding(entry.Encoding)
+		g.Expect(found).To(BeTrue(), entry.Description)
+		g.Expect(byEncoding).To(Equal(byCodePage), entry.Description)
+
+		codePage, found := CodePageForEncoding(entry.Encoding)
+		g.Expect(found).To(BeTrue(), entry.Description)
+		g.Expect(codePage).To(Equal(entry.CodePage), entry.Description)
+	}
+}
+
+func TestLanguageDrivers_SharedCodePage_FirstIDPreferred(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	expectedIDs := map[int]LanguageDriver{437: 0x01, 850: 0x02, 1252: 0x03, 866: 0x26, 852: 0x1F}
+	for codePage, expectedID := range expectedIDs {
+		languageDriver, found := LanguageDriverForCodePage(codePage)
+		g.Expect(found).To(BeTrue())
+		g.Expect(languageDriver).To(Equal(expectedID))
+	}
+
+	g.Expect(LanguageDriver(0x65).CodePage()).To(Equal(866))
+	g.Expect(LanguageDriver(0x59).CodePage()).To(Equal(1252))
+}
+
+func TestLanguageDriverForEncoding_Aliases(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	expectedIDs := map[string]LanguageDriver{"cp866": 0x26, "1251": 0xC9, "936": 0x4D, "IBM437": 0x01, "windows-1252": 0x03}
+	for encoding, expectedID := range expectedIDs {
+		languageDriver, found := LanguageDriverForEncoding(encoding)
+		g.Expect(found).To(BeTrue(), encoding)
+		g.Expect(languageDriver).To(Equal(expectedID), encoding)
+	}
+
+	_, found := LanguageDriverForEncoding("UTF-8")
+	g.Expect(found).To(BeFalse())
+	_, found = LanguageDriverForCodePage(1258)
+	g.Expect(found).To(BeFalse())
+	g.Expect(LanguageDriver(0xFF).CodePage()).To(Equal(0))
+}
+
+func TestNew_LanguageDriverWrittenForEncoding(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(New("IBM866").LanguageDriver()).To(Equal(LanguageDriver(0x26)))
+	g.Expect(New("windows-1251").LanguageDriver()).To(Equal(LanguageDriver(0xC9)))
+	g.Expect(New("UTF-8").LanguageDriver()).To(Equal(LanguageDriver(0)))
+}
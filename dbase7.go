@@ -0,0 +1,399 @@
+package godbf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+	"time"
+)
+
+// dBase 7 (Level 7) tables extend the table header to 68 bytes, adding a 32-byte language driver name, and use 48-byte
+// field descriptors holding field names of up to 31 characters. A field properties structure, describing field
+// constraints such as required, minimum, maximum and default values, follows the field descriptor array terminator.
+// Level 7 numeric types are stored big-endian, with their sign bit flipped (or, for negative floating point values,
+// all bits inverted) so that their binary encodings sort in the same order as their values.
+// For reference: https://www.dbase.com/Knowledgebase/INT/db7_file_fmt.htm
+
+const (
+	dbaseVIIFileSignature         byte = 0x04
+	dbaseVIIWithMemoFileSignature byte = 0x8C
+
+	level7HeaderLength              = 68
+	level7LanguageDriverNameOffset  = 32
+	level7LanguageDriverNameLength  = 32
+	level7FieldDescriptorLength     = 48
+	level7FieldNameByteLength       = 32
+	level7MaxUsableNameByteLength   = level7FieldNameByteLength - 1
+	level7FieldTypeOffset           = 32
+	level7FieldLengthOffset         = 33
+	level7DecimalCountOffset        = 34
+	level7ProductionIndexFlagOffset = 37
+	level7AutoincrementOffset       = 40
+
+	level7FieldPropertiesHeaderLength = 16
+	level7StandardPropertyLength      = 15
+	level7FieldPropertiesDataOffset   = 12
+	level7FieldPropertiesSizeOffset   = 14
+	level7StandardPropertyCountOffset = 0
+	level7StandardPropertyArrayOffset = 2
+	level7CustomPropertyArrayOffset   = 6
+	level7ReferentialIntegrityOffset  = 10
+	level7StandardPropertyFieldOffset = 2
+	level7StandardPropertyTypeOffset  = 4
+	level7StandardPropertyValueOffset = 7
+	level7StandardPropertyWidthOffset = 11
+	level7CustomPropertyLength        = 14
+	level7CustomPropertyCountOffset   = 4
+	level7CustomPropertyFieldOffset   = 2
+	level7SignBit                     = 1 << 63
+	level7LongSignBit                 = 1 << 31
+)
+
+const (
+	Autoincrement DbaseDataType = '+' // dBase 7 Long, assigned the next value in sequence as records are added
+	Level7Double  DbaseDataType = 'O' // dBase 7 Double, a 64-bit floating point value
+	Timestamp     DbaseDataType = '@' // dBase 7 Timestamp, a date and time of day
+
+	// Binary is the dBase 7 binary memo type. It shares its type byte with Visual FoxPro's Double, and is only
+	// interpreted as a memo in dBase 7 tables.
+	Binary DbaseDataType = 'B'
+)
+
+// FieldPropertyType identifies the constraint a dBase 7 standard field property describes.
+type FieldPropertyType byte
+
+const (
+	RequiredProperty   FieldPropertyType = 0x01
+	MinimumProperty    FieldPropertyType = 0x02
+	MaximumProperty    FieldPropertyType = 0x03
+	DefaultProperty    FieldPropertyType = 0x04
+	ConstraintProperty FieldPropertyType = 0x06
+)
+
+// FieldProperty is a standard property from a dBase 7 table's field properties structure.
+type FieldProperty struct {
+	FieldName string            // Name of the field the property applies to, or empty for a database constraint
+	Property  FieldPropertyType // The constraint the property describes
+	Value     []byte            // Raw value of the property, stored as the field stores it. Empty for RequiredProperty.
+}
+
+// NewDbase7 creates a new dBase 7 (Level 7) table from scratch for the given character encoding.
+func NewDbase7(encoding string) (table *DbfTable) {
+	return newTable(encoding, dbaseVIIFileSignature, level7HeaderLength)
+}
+
+// isDbase7 returns true if the file signature identifies a dBase 7 table.
+func (h *header) isDbase7() bool {
+	return h.fileSignature == dbaseVIIFileSignature || h.fileSignature == dbaseVIIWithMemoFileSignature
+}
+
+// headerPrefixLength returns the length of the table header preceding the field descriptor array.
+func (h *header) headerPrefixLength() int {
+	if h.isDbase7() {
+		return level7HeaderLength
+	}
+	return 32
+}
+
+// maxFieldNameLength returns the maximum length in bytes of the table's field names.
+func (h *header) maxFieldNameLength() int {
+	if h.isDbase7() {
+		return level7MaxUsableNameByteLength
+	}
+	return maxUsableNameByteLength
+}
+
+// doubleType returns the data type AddDoubleField uses for the table's level.
+func (h *header) doubleType() DbaseDataType {
+	if h.isDbase7() {
+		return Level7Double
+	}
+	return Double
+}
+
+// dateTimeType returns the data type AddDateTimeField uses for the table's level.
+func (h *header) dateTimeType() DbaseDataType {
+	if h.isDbase7() {
+		return Timestamp
+	}
+	return DateTime
+}
+
+// storesBinary returns true if the field's content is stored as binary in the table's records, rather than as text.
+func (h *header) storesBinary(fd FieldDescriptor) bool {
+	if h.isDbase7() && fd.fieldType == Binary {
+		return false
+	}
+	return fd.fieldType.isBinary()
+}
+
+// storesInMemo returns true if the field's content is kept in the table's memo file.
+func (h *header) storesInMemo(fd FieldDescriptor) bool {
+	return fd.fieldType.usesMemo() || (h.isDbase7() && fd.fieldType == Binary)
+}
+
+// LanguageDriverName returns the name of the language driver recorded in a dBase 7 table header, or an empty string
+// for tables of other levels.
+func (dt *DbfTable) LanguageDriverName() string {
+	defer dt.lockForReading()()
+	if !dt.isDbase7() || len(dt.dataStore) < level7HeaderLength {
+		return ""
+	}
+	name := dt.dataStore[level7LanguageDriverNameOffset : level7LanguageDriverNameOffset+level7LanguageDriverNameLength]
+	if end := bytes.IndexByte(name, null); end != -1 {
+		name = name[:end]
+	}
+	return string(name)
+}
+
+// AddAutoincrementField adds a dBase 7 Autoincrement field to the table. Each record added to the table is assigned
+// the next value in sequence, starting from 1.
+// An error is returned if the table is not a dBase 7 table.
+func (dt *DbfTable) AddAutoincrementField(fieldName string) (err error) {
+	if !dt.isDbase7() {
+		return errors.New("Autoincrement fields are only supported by dBase 7 tables")
+	}
+	return dt.addField(fieldName, Autoincrement, Autoincrement.fixedFieldLength(), Autoincrement.decimalCountNotApplicable())
+}
+
+// FieldProperties returns the standard properties held in a dBase 7 table's field properties structure.
+// Tables of other levels have no field properties.
+func (dt *DbfTable) FieldProperties() []FieldProperty {
+	s := dt.fieldProperties
+	if len(s) < level7FieldPropertiesHeaderLength {
+		return nil
+	}
+
+	count := int(binary.LittleEndian.Uint16(s[level7StandardPropertyCountOffset:]))
+	start := int(binary.LittleEndian.Uint16(s[level7StandardPropertyArrayOffset:]))
+
+	properties := make([]FieldProperty, 0, count)
+	for i := 0; i < count; i++ {
+		offset := start + i*level7StandardPropertyLength
+		if offset+level7StandardPropertyLength > len(s) {
+			break
+		}
+		descriptor := s[offset : offset+level7StandardPropertyLength]
+
+		property := FieldProperty{Property: FieldPropertyType(descriptor[level7StandardPropertyTypeOffset])}
+
+		if fieldNumber := int(binary.LittleEndian.Uint16(descriptor[level7StandardPropertyFieldOffset:])); fieldNumber > 0 && fieldNumber <= len(dt.fields) {
+			property.FieldName = dt.fields[fieldNumber-1].name
+		}
+
+		valueOffset := int(binary.LittleEndian.Uint32(descriptor[level7StandardPropertyValueOffset:]))
+		valueWidth := int(binary.LittleEndian.Uint16(descriptor[level7StandardPropertyWidthOffset:]))
+		if valueOffset > 0 && valueOffset+valueWidth <= len(s) {
+			property.Value = append([]byte(nil), s[valueOffset:valueOffset+valueWidth]...)
+		}
+
+		properties = append(properties, property)
+	}
+	return properties
+}
+
+// assignAutoincrementValues gives each Autoincrement field of the given row the next value in its sequence, recording
+// the value that follows in the field's descriptor. If the record storage cannot read the row, an error is returned.
+func (dt *DbfTable) assignAutoincrementValues(row int) error {
+	for i := range dt.fields {
+		field := &dt.fields[i]
+		if field.fieldType != Autoincrement {
+			continue
+		}
+
+		if field.nextAutoincrementValue == 0 {
+			field.nextAutoincrementValue = 1
+		}
+		fieldBytes, bytesErr := dt.fieldBytes(row, i)
+		if bytesErr != nil {
+			return bytesErr
+		}
+		dt.encodeIntegerField(fieldBytes, int32(field.nextAutoincrementValue))
+		field.nextAutoincrementValue++
+
+		descriptorOffset := level7HeaderLength + i*level7FieldDescriptorLength
+		binary.LittleEndian.PutUint32(dt.dataStore[descriptorOffset+level7AutoincrementOffset:], field.nextAutoincrementValue)
+	}
+	return nil
+}
+
+// unpackLevel7Fields reads the 48-byte field descriptors of a dBase 7 table, and the field properties structure that
+// follows them.
+func unpackLevel7Fields(s []byte, dt *DbfTable) error {
+	dt.fieldMap = make(map[string]int)
+
+	dt.numberOfFields = 0
+	offset := level7HeaderLength
+	for ; offset+level7FieldDescriptorLength <= int(dt.numberOfBytesInHeader) && s[offset] != fieldDescriptorArrayTerminator; offset += level7FieldDescriptorLength {
+		if unpackFieldErr := unpackLevel7Field(s, dt, dt.numberOfFields, offset); unpackFieldErr != nil {
+			return unpackFieldErr
+		}
+		dt.numberOfFields++
+	}
+
+	if propertiesStart := offset + 1; propertiesStart < int(dt.numberOfBytesInHeader) {
+		dt.fieldProperties = append([]byte(nil), s[propertiesStart:dt.numberOfBytesInHeader]...)
+	}
+	return nil
+}
+
+func unpackLevel7Field(s []byte, dt *DbfTable, fieldIndex int, offset int) error {
+	descriptor := s[offset : offset+level7FieldDescriptorLength]
+
+	nameBytes := descriptor[:level7FieldNameByteLength]
+	if end := bytes.IndexByte(nameBytes, endOfFieldNameMarker); end != -1 {
+		nameBytes = nameBytes[:end]
+	}
+	fieldName, _ := dt.textCodec().Decode(nameBytes)
+
+	dt.fieldMap[fieldName] = fieldIndex
+
+	fieldType := DbaseDataType(descriptor[level7FieldTypeOffset])
+	switch fieldType {
+	case Character, Numeric, Float, Logical, Date, Memo, General, Binary, Integer, Autoincrement, Level7Double, Timestamp:
+		if addErr := dt.addField(fieldName, fieldType, descriptor[level7FieldLengthOffset], descriptor[level7DecimalCountOffset]); addErr != nil {
+			return addErr
+		}
+	default:
+		return &UnsupportedFieldTypeError{FieldName: fieldName, FieldType: fieldType, Offset: offset}
+	}
+
+	field := &dt.fields[len(dt.fields)-1]
+	field.productionIndexFlag = descriptor[level7ProductionIndexFlagOffset]
+	field.nextAutoincrementValue = binary.LittleEndian.Uint32(descriptor[level7AutoincrementOffset:])
+	return verifyFieldLength(dt, *field, offset+level7FieldLengthOffset)
+}
+
+// packLevel7FieldDescriptor encodes the 48-byte dBase 7 field descriptor of the given field.
+func (dt *DbfTable) packLevel7FieldDescriptor(fd FieldDescriptor) []byte {
+	descriptor := make([]byte, level7FieldDescriptorLength)
+	nameBytes, _ := dt.convertToByteSlice(fd.name, level7MaxUsableNameByteLength)
+	copy(descriptor, nameBytes)
+	descriptor[level7FieldTypeOffset] = fd.fieldType.byte()
+	descriptor[level7FieldLengthOffset] = fd.length
+	descriptor[level7DecimalCountOffset] = fd.decimalPlaces
+	descriptor[level7ProductionIndexFlagOffset] = fd.productionIndexFlag
+	binary.LittleEndian.PutUint32(descriptor[level7AutoincrementOffset:], fd.nextAutoincrementValue)
+	return descriptor
+}
+
+// packFieldProperties appends the field properties structure to a dBase 7 header under construction. Tables created
+// from scratch are given an empty structure.
+func (dt *DbfTable) packFieldProperties(slice []byte) []byte {
+	if len(dt.fieldProperties) > 0 {
+		return append(slice, dt.fieldProperties...)
+	}
+
+	properties := make([]byte, level7FieldPropertiesHeaderLength)
+	for _, arrayOffset := range []int{level7StandardPropertyArrayOffset, level7CustomPropertyArrayOffset, level7ReferentialIntegrityOffset, level7FieldPropertiesDataOffset, level7FieldPropertiesSizeOffset} {
+		binary.LittleEndian.PutUint16(properties[arrayOffset:], level7FieldPropertiesHeaderLength)
+	}
+	return append(slice, properties...)
+}
+
+// renumberFieldProperties changes the field numbers of the standard and custom properties in the table's field
+// properties structure as the fields are reordered, where fieldNumbers maps the 1-based number of each existing field
+// to its new number. The properties of fields missing from fieldNumbers are removed, and their descriptors zeroed,
+// leaving the layout of the structure, and the data its other properties refer to, in place.
+func (dt *DbfTable) renumberFieldProperties(fieldNumbers map[int]int) {
+	if len(dt.fieldProperties) < level7FieldPropertiesHeaderLength {
+		return
+	}
+	s := slices.Clone(dt.fieldProperties)
+
+	descriptorArrays := []struct{ countOffset, arrayOffset, fieldOffset, length int }{
+		{level7StandardPropertyCountOffset, level7StandardPropertyArrayOffset, level7StandardPropertyFieldOffset, level7StandardPropertyLength},
+		{level7CustomPropertyCountOffset, level7CustomPropertyArrayOffset, level7CustomPropertyFieldOffset, level7CustomPropertyLength},
+	}
+	for _, array := range descriptorArrays {
+		count := int(binary.LittleEndian.Uint16(s[array.countOffset:]))
+		start := int(binary.LittleEndian.Uint16(s[array.arrayOffset:]))
+
+		kept := 0
+		for i := 0; i < count && start+(i+1)*array.length <= len(s); i++ {
+			descriptor := s[start+i*array.length : start+(i+1)*array.length]
+			fieldNumber, found := fieldNumbers[int(binary.LittleEndian.Uint16(descriptor[array.fieldOffset:]))]
+			if !found {
+				continue
+			}
+			binary.LittleEndian.PutUint16(descriptor[array.fieldOffset:], uint16(fieldNumber))
+			copy(s[start+kept*array.length:], descriptor)
+			kept++
+		}
+
+		clear(s[min(start+kept*array.length, len(s)):min(start+count*array.length, len(s))])
+		binary.LittleEndian.PutUint16(s[array.countOffset:], uint16(kept))
+	}
+	dt.fieldProperties = s
+}
+
+// isZeroed returns true if every byte is 0x00, which dBase 7 uses to mark binary numeric fields that hold no value.
+func isZeroed(fieldBytes []byte) bool {
+	for _, b := range fieldBytes {
+		if b != null {
+			return false
+		}
+	}
+	return true
+}
+
+func decodeLong(fieldBytes []byte) int32 {
+	if isZeroed(fieldBytes) {
+		return 0
+	}
+	return int32(binary.BigEndian.Uint32(fieldBytes) ^ level7LongSignBit)
+}
+
+func encodeLong(fieldBytes []byte, value int32) {
+	binary.BigEndian.PutUint32(fieldBytes, uint32(value)^level7LongSignBit)
+}
+
+func decodeLevel7Double(fieldBytes []byte) float64 {
+	if isZeroed(fieldBytes) {
+		return 0
+	}
+	bits := binary.BigEndian.Uint64(fieldBytes)
+	if bits&level7SignBit != 0 {
+		bits ^= level7SignBit
+	} else {
+		bits = ^bits
+	}
+	return math.Float64frombits(bits)
+}
+
+func encodeLevel7Double(fieldBytes []byte, value float64) {
+	bits := math.Float64bits(value)
+	if bits&level7SignBit == 0 {
+		bits ^= level7SignBit
+	} else {
+		bits = ^bits
+	}
+	binary.BigEndian.PutUint64(fieldBytes, bits)
+}
+
+// decodeTimestamp interprets a Timestamp field's count of milliseconds since the start of Julian day 0 as a
+// time.Time in UTC. isSet is false if the field holds no date.
+func decodeTimestamp(fieldBytes []byte) (value time.Time, isSet bool) {
+	if isZeroed(fieldBytes) {
+		return time.Time{}, false
+	}
+
+	milliseconds := int64(decodeLevel7Double(fieldBytes))
+	unixMilliseconds := milliseconds - unixEpochJulianDay*millisecondsPerDay
+	return time.UnixMilli(unixMilliseconds).UTC(), true
+}
+
+// encodeTimestamp stores the wall-clock date and time of value, ignoring its location, as a Timestamp field's count
+// of milliseconds since the start of Julian day 0. A zero value clears the field.
+func encodeTimestamp(fieldBytes []byte, value time.Time) {
+	if value.IsZero() {
+		clear(fieldBytes)
+		return
+	}
+
+	wallClock := time.Date(value.Year(), value.Month(), value.Day(), value.Hour(), value.Minute(), value.Second(), value.Nanosecond(), time.UTC)
+	milliseconds := wallClock.UnixMilli() + unixEpochJulianDay*millisecondsPerDay
+	encodeLevel7Double(fieldBytes, float64(milliseconds))
+}
@@ -0,0 +1,192 @@
+package godbf
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testLanguageDriverName = "DBWINUS0"
+	testLongFieldName      = "A_FIELD_NAME_LONGER_THAN_TEN"
+)
+
+// dbase7TestBytes encodes a dBase 7 table with a Character field with a long name and a Long field, a field properties
+// structure giving the Character field a Required property and the Long field a Default property of 5, and one record.
+func dbase7TestBytes() []byte {
+	const fieldCount = 2
+	const recordLength = 1 + 4 + 4
+
+	properties := make([]byte, level7FieldPropertiesHeaderLength+2*level7StandardPropertyLength+4)
+	binary.LittleEndian.PutUint16(properties[0:], 2)
+	binary.LittleEndian.PutUint16(properties[2:], level7FieldPropertiesHeaderLength)
+	binary.LittleEndian.PutUint16(properties[6:], uint16(len(properties)-4))
+	binary.LittleEndian.PutUint16(properties[10:], uint16(len(properties)-4))
+	binary.LittleEndian.PutUint16(properties[12:], uint16(len(properties)-4))
+	binary.LittleEndian.PutUint16(properties[14:], uint16(len(properties)))
+
+	required := properties[level7FieldPropertiesHeaderLength:]
+	binary.LittleEndian.PutUint16(required[2:], 1)
+	required[4] = byte(RequiredProperty)
+	required[5] = Character.byte()
+	required[6] = 0x02
+
+	defaultValue := properties[level7FieldPropertiesHeaderLength+level7StandardPropertyLength:]
+	binary.LittleEndian.PutUint16(defaultValue[2:], 2)
+	defaultValue[4] = byte(DefaultProperty)
+	defaultValue[5] = Integer.byte()
+	defaultValue[6] = 0x02
+	binary.LittleEndian.PutUint32(defaultValue[7:], uint32(len(properties)-4))
+	binary.LittleEndian.PutUint16(defaultValue[11:], 4)
+	copy(properties[len(properties)-4:], []byte{0x80, 0x00, 0x00, 0x05})
+
+	headerLength := level7HeaderLength + fieldCount*level7FieldDescriptorLength + 1 + len(properties)
+
+	data := make([]byte, headerLength)
+	data[0] = dbaseVIIFileSignature
+	data[1], data[2], data[3] = 119, 1, 2
+	copy(data[4:8], uint32ToBytes(1))
+	copy(data[8:10], uint32ToBytes(uint32(headerLength)))
+	copy(data[10:12], uint32ToBytes(recordLength))
+	data[29] = 0x57
+	copy(data[level7LanguageDriverNameOffset:], testLanguageDriverName)
+
+	fieldDescriptor := func(index int, name string, fieldType DbaseDataType, length byte) {
+		descriptor := data[level7HeaderLength+index*level7FieldDescriptorLength:]
+		copy(descriptor, name)
+		descriptor[level7FieldTypeOffset] = fieldType.byte()
+		descriptor[level7FieldLengthOffset] = length
+	}
+	fieldDescriptor(0, testLongFieldName, Character, 4)
+	fieldDescriptor(1, "COUNT", Integer, 4)
+
+	data[level7HeaderLength+fieldCount*level7FieldDescriptorLength] = fieldDescriptorArrayTerminator
+	copy(data[level7HeaderLength+fieldCount*level7FieldDescriptorLength+1:], properties)
+
+	data = append(data, ' ', 'a', 'b', 'c', 'd', 0x7F, 0xFF, 0xFF, 0xFF)
+	return append(data, eofMarker)
+}
+
+func TestNewFromByteArray_Dbase7_FieldsAreCorrect(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, newErr := NewFromByteArray(dbase7TestBytes(), testEncoding)
+	g.Expect(newErr).To(BeNil())
+
+	g.Expect(tableUnderTest.FieldNames()).To(Equal([]string{testLongFieldName, "COUNT"}))
+	g.Expect(tableUnderTest.LanguageDriverName()).To(Equal(testLanguageDriverName))
+	g.Expect(tableUnderTest.GetRowAsSlice(0)).To(Equal([]string{"abcd", "-1"}))
+	g.Expect(tableUnderTest.IntegerFieldValueByName(0, "COUNT")).To(BeNumerically("==", -1))
+}
+
+func TestNewFromByteArray_Dbase7_FieldPropertiesAreCorrect(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromByteArray(dbase7TestBytes(), testEncoding)
+
+	g.Expect(tableUnderTest.FieldProperties()).To(Equal([]FieldProperty{
+		{FieldName: testLongFieldName, Property: RequiredProperty},
+		{FieldName: "COUNT", Property: DefaultProperty, Value: []byte{0x80, 0x00, 0x00, 0x05}},
+	}))
+}
+
+func TestDbfTable_UpdateDataStore_Dbase7HeaderIsRebuilt(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	originalBytes := dbase7TestBytes()
+	tableUnderTest, _ := NewFromByteArray(dbase7TestBytes(), testEncoding)
+
+	tableUnderTest.updateDataStore()
+
+	headerLength := int(tableUnderTest.numberOfBytesInHeader)
+	g.Expect(headerLength).To(Equal(len(originalBytes) - 9 - 1))
+	g.Expect(tableUnderTest.dataStore[:headerLength]).To(Equal(originalBytes[:headerLength]))
+}
+
+func TestNewDbase7_AddFields(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := NewDbase7(testEncoding)
+	g.Expect(tableUnderTest.AddTextField(testLongFieldName+"_AND_LONGER_THAN_THIRTY_ONE", 10)).To(Succeed())
+	g.Expect(tableUnderTest.AddAutoincrementField("ID")).To(Succeed())
+	g.Expect(tableUnderTest.AddDoubleField("AMOUNT", 2)).To(Succeed())
+	g.Expect(tableUnderTest.AddDateTimeField("STAMP")).To(Succeed())
+
+	fields := tableUnderTest.Fields()
+	g.Expect(fields[0].Name()).To(HaveLen(level7MaxUsableNameByteLength))
+	g.Expect(fields[1].FieldType()).To(Equal(Autoincrement))
+	g.Expect(fields[2].FieldType()).To(Equal(Level7Double))
+	g.Expect(fields[3].FieldType()).To(Equal(Timestamp))
+
+	expectedHeaderLength := level7HeaderLength + 4*level7FieldDescriptorLength + 1 + level7FieldPropertiesHeaderLength
+	g.Expect(tableUnderTest.numberOfBytesInHeader).To(BeNumerically("==", expectedHeaderLength))
+	g.Expect(tableUnderTest.lengthOfEachRecord).To(BeNumerically("==", 1+10+4+8+8))
+}
+
+func TestDbfTable_AddAutoincrementField_NotDbase7_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+
+	additionError := tableUnderTest.AddAutoincrementField("ID")
+	g.Expect(additionError).ToNot(BeNil())
+	t.Log(additionError)
+}
+
+func TestDbfTable_Dbase7_BinaryEncodingsSort(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	longBytes := make([]byte, 4)
+	encodeLong(longBytes, 1)
+	g.Expect(longBytes).To(Equal([]byte{0x80, 0x00, 0x00, 0x01}))
+	encodeLong(longBytes, -1)
+	g.Expect(longBytes).To(Equal([]byte{0x7F, 0xFF, 0xFF, 0xFF}))
+
+	doubleBytes := make([]byte, 8)
+	encodeLevel7Double(doubleBytes, 1)
+	g.Expect(doubleBytes).To(Equal([]byte{0xBF, 0xF0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}))
+	encodeLevel7Double(doubleBytes, -1)
+	g.Expect(doubleBytes).To(Equal([]byte{0x40, 0x0F, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}))
+	g.Expect(decodeLevel7Double(doubleBytes)).To(Equal(-1.0))
+}
+
+func TestSaveToFile_Dbase7_LoadOfSavedIsCorrect(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableToSave := NewDbase7(testEncoding)
+	tableToSave.AddTextField(testLongFieldName, 10)
+	tableToSave.AddAutoincrementField("ID")
+	tableToSave.AddDoubleField("AMOUNT", 2)
+	tableToSave.AddDateTimeField("STAMP")
+	tableToSave.AddMemoField("NOTES")
+
+	expectedStamp := time.Date(2019, time.March, 4, 13, 14, 15, 0, time.UTC)
+
+	firstRecord, _ := tableToSave.AddNewRecord()
+	tableToSave.SetFieldValueByName(firstRecord, testLongFieldName, "first")
+	tableToSave.SetDoubleFieldValueByName(firstRecord, "AMOUNT", -12.5)
+	tableToSave.SetDateTimeFieldValueByName(firstRecord, "STAMP", expectedStamp)
+	tableToSave.SetFieldValueByName(firstRecord, "NOTES", "a dBase 7 memo")
+	secondRecord, _ := tableToSave.AddNewRecord()
+
+	g.Expect(tableToSave.fileSignature).To(Equal(dbaseVIIWithMemoFileSignature))
+
+	tempFilename := filepath.Join("testdata", "tempSavedDbase7Table.dbf")
+	g.Expect(SaveToFile(tableToSave, tempFilename)).To(Succeed())
+
+	tableUnderTest, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+
+	g.Expect(tableUnderTest.FieldNames()).To(Equal([]string{testLongFieldName, "ID", "AMOUNT", "STAMP", "NOTES"}))
+	g.Expect(tableUnderTest.GetRowAsSlice(firstRecord)).To(Equal([]string{"first", "1", "-12.50", "20190304131415", "a dBase 7 memo"}))
+	g.Expect(tableUnderTest.GetRowAsSlice(secondRecord)).To(Equal([]string{"", "2", "", "", ""}))
+	g.Expect(tableUnderTest.DateTimeFieldValueByName(firstRecord, "STAMP")).To(Equal(expectedStamp))
+	g.Expect(tableUnderTest.Fields()[1].nextAutoincrementValue).To(BeNumerically("==", 3))
+
+	g.Expect(os.Remove(tempFilename)).To(Succeed())
+	g.Expect(os.Remove(companionFileName(tempFilename, dbtFileExtension))).To(Succeed())
+}
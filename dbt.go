@@ -0,0 +1,158 @@
+package godbf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// dbtVersion distinguishes between the dBase III and dBase IV variants of the .DBT memo file format.
+type dbtVersion byte
+
+const (
+	dbaseIIIMemo dbtVersion = 3
+	dbaseIVMemo  dbtVersion = 4
+)
+
+const (
+	dbtFileExtension = ".dbt"
+
+	dbtDefaultBlockSize         = 512
+	dbtNextFreeBlockOffset      = 0
+	dbtVersionOffset            = 16
+	dbtBlockSizeOffset          = 20
+	dbtMemoTerminator      byte = 0x1A
+
+	dbaseIVMemoHeaderLength = 8
+)
+
+// dbaseIVMemoMarker starts every memo block of a dBase IV .DBT file.
+var dbaseIVMemoMarker = []byte{0xFF, 0xFF, 0x08, 0x00}
+
+// dbtMemo is a memoStore for the dBase III and dBase IV .DBT memo file formats.
+// For reference: http://www.clicketyclick.dk/databases/xbase/format/dbt.html
+//
+// Both formats divide the file into fixed-size blocks, the first of which is a header holding the number of the next
+// free block. A dBase III memo is terminated with a pair of 0x1A bytes, whereas a dBase IV memo is prefixed by an
+// 8-byte block header of a marker followed by the memo's length (including the block header). Neither format records
+// the type of a memo's content, so all memos are treated as text.
+type dbtMemo struct {
+	version   dbtVersion
+	blockSize int
+	data      []byte
+}
+
+// newDbtMemo creates an empty .DBT memo file of the specified version.
+func newDbtMemo(version dbtVersion) *dbtMemo {
+	m := &dbtMemo{
+		version:   version,
+		blockSize: dbtDefaultBlockSize,
+		data:      make([]byte, dbtDefaultBlockSize),
+	}
+
+	m.setNextFreeBlock(1)
+	switch version {
+	case dbaseIIIMemo:
+		m.data[dbtVersionOffset] = byte(dbaseIIIMemo)
+	case dbaseIVMemo:
+		binary.LittleEndian.PutUint16(m.data[dbtBlockSizeOffset:], uint16(m.blockSize))
+	}
+
+	return m
+}
+
+// newDbtMemoFromBytes interprets the raw bytes of a .DBT memo file of the specified version.
+func newDbtMemoFromBytes(version dbtVersion, data []byte) (*dbtMemo, error) {
+	if len(data) < dbtBlockSizeOffset+2 {
+		return nil, fmt.Errorf("memo file is %d bytes, too short to hold a memo file header", len(data))
+	}
+
+	m := &dbtMemo{version: version, blockSize: dbtDefaultBlockSize, data: data}
+
+	if version == dbaseIVMemo {
+		if blockSize := binary.LittleEndian.Uint16(data[dbtBlockSizeOffset:]); blockSize != 0 {
+			m.blockSize = int(blockSize)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *dbtMemo) nextFreeBlock() uint32 {
+	return binary.LittleEndian.Uint32(m.data[dbtNextFreeBlockOffset:])
+}
+
+func (m *dbtMemo) setNextFreeBlock(block uint32) {
+	binary.LittleEndian.PutUint32(m.data[dbtNextFreeBlockOffset:], block)
+}
+
+func (m *dbtMemo) readMemo(block uint32) ([]byte, MemoBlockType, error) {
+	start := int(block) * m.blockSize
+	if block == 0 || start >= len(m.data) {
+		return nil, TextMemoBlock, fmt.Errorf("memo block %d lies outside the memo file", block)
+	}
+
+	content := m.data[start:]
+
+	if m.version == dbaseIVMemo {
+		if len(content) < dbaseIVMemoHeaderLength || !bytes.Equal(content[:len(dbaseIVMemoMarker)], dbaseIVMemoMarker) {
+			return nil, TextMemoBlock, fmt.Errorf("memo block %d does not start with a dBase IV memo marker", block)
+		}
+		length := int(binary.LittleEndian.Uint32(content[len(dbaseIVMemoMarker):dbaseIVMemoHeaderLength]))
+		if length < dbaseIVMemoHeaderLength || length > len(content) {
+			return nil, TextMemoBlock, fmt.Errorf("memo block %d declares a length of %d bytes, but only %d remain", block, length, len(content))
+		}
+		content = content[dbaseIVMemoHeaderLength:length]
+	} else if end := bytes.IndexByte(content, dbtMemoTerminator); end >= 0 {
+		content = content[:end]
+	}
+
+	return bytes.Clone(content), TextMemoBlock, nil
+}
+
+func (m *dbtMemo) writeMemo(content []byte, _ MemoBlockType) (uint32, error) {
+	var entry []byte
+	if m.version == dbaseIVMemo {
+		entry = append(entry, dbaseIVMemoMarker...)
+		entry = binary.LittleEndian.AppendUint32(entry, uint32(len(content)+dbaseIVMemoHeaderLength))
+		entry = append(entry, content...)
+	} else {
+		entry = append(entry, content...)
+		entry = append(entry, dbtMemoTerminator, dbtMemoTerminator)
+	}
+
+	blocksUsed := (len(entry) + m.blockSize - 1) / m.blockSize
+	entry = append(entry, make([]byte, blocksUsed*m.blockSize-len(entry))...)
+
+	// a next free block in the header, or below the end of the file, would overwrite it
+	block := max(m.nextFreeBlock(), m.firstFreeBlock(), uint32((len(m.data)+m.blockSize-1)/m.blockSize))
+	start := int(block) * m.blockSize
+	m.data = append(m.data, make([]byte, start-len(m.data))...)
+	m.data = append(m.data, entry...)
+	m.setNextFreeBlock(block + uint32(blocksUsed))
+
+	return block, nil
+}
+
+func (m *dbtMemo) bytes() []byte {
+	return m.data
+}
+
+func (m *dbtMemo) empty() memoStore {
+	firstFreeBlock := m.firstFreeBlock()
+
+	e := &dbtMemo{version: m.version, blockSize: m.blockSize, data: make([]byte, int(firstFreeBlock)*m.blockSize)}
+	copy(e.data, m.data)
+	e.setNextFreeBlock(firstFreeBlock)
+	return e
+}
+
+// firstFreeBlock returns the number of the first block following the header, which fills at least the first 512
+// bytes, whatever the block size.
+func (m *dbtMemo) firstFreeBlock() uint32 {
+	return uint32((max(m.blockSize, dbtDefaultBlockSize) + m.blockSize - 1) / m.blockSize)
+}
+
+func (m *dbtMemo) fileExtension() string {
+	return dbtFileExtension
+}
@@ -1,8 +1,181 @@
 package godbf
 
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// Encoding converts the text of a table between Go strings and the bytes the table holds it as. Encodings are named
+// when a table is created or read, and resolved through the encodings registered with RegisterEncoding, the aliases
+// and code page numbers they are known by, and the IANA names of the encodings of golang.org/x/text.
+type Encoding interface {
+	// Encode returns text as the bytes of the encoding. Characters the encoding cannot represent are substituted with
+	// '?', and reported by an error matching ErrUnmappableCharacter, returned along with the substituted content.
+	Encode(text string) ([]byte, error)
+
+	// Decode returns the text of content in the encoding. Bytes that decode to no character are substituted with
+	// U+FFFD, and reported by an error matching ErrUnmappableCharacter, returned along with the substituted text.
+	Decode(content []byte) (string, error)
+}
+
+// substituteByte replaces characters an encoding cannot represent.
+const substituteByte = '?'
+
+// NewTextEncoding returns an Encoding converting text with an encoding of golang.org/x/text, for use with
+// RegisterEncoding.
+func NewTextEncoding(textEncoding encoding.Encoding) Encoding {
+	return &xTextEncoding{encoding: textEncoding}
+}
+
+// xTextEncoding is an Encoding converting text with an encoding of golang.org/x/text.
+type xTextEncoding struct {
+	encoding encoding.Encoding
+}
+
+func (e *xTextEncoding) Encode(text string) ([]byte, error) {
+	content, encodeErr := e.encoding.NewEncoder().Bytes([]byte(text))
+	if encodeErr == nil {
+		return content, nil
+	}
+
+	// Encode character by character, to substitute those the encoding cannot represent.
+	content = make([]byte, 0, len(text))
+	var unmappable []rune
+	encoder := e.encoding.NewEncoder()
+	for _, r := range text {
+		encoded, runeErr := encoder.Bytes([]byte(string(r)))
+		if runeErr != nil {
+			content = append(content, substituteByte)
+			unmappable = append(unmappable, r)
+			continue
+		}
+		content = append(content, encoded...)
+	}
+	return content, unmappableCharactersError(unmappable)
+}
+
+func (e *xTextEncoding) Decode(content []byte) (string, error) {
+	decoded, decodeErr := e.encoding.NewDecoder().Bytes(content)
+	if decodeErr != nil {
+		return string(decoded), fmt.Errorf("%w: %w", ErrUnmappableCharacter, decodeErr)
+	}
+
+	// The decoders of golang.org/x/text substitute bytes that decode to no character with U+FFFD, rather than fail.
+	text := string(decoded)
+	return text, undecodableBytesError(strings.Count(text, "\uFFFD") - strings.Count(string(content), "\uFFFD"))
+}
+
+// unmappableCharactersError returns an error reporting the given characters an encoding cannot represent, or nil if
+// there are none.
+func unmappableCharactersError(characters []rune) error {
+	if len(characters) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %q", ErrUnmappableCharacter, string(characters))
+}
+
+// undecodableBytesError returns an error reporting the given number of bytes that decode to no character, or nil if
+// there are none.
+func undecodableBytesError(count int) error {
+	if count <= 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %d bytes decode to no character", ErrUnmappableCharacter, count)
+}
+
+var (
+	encodingsLock sync.RWMutex
+
+	// encodings holds the Encoding of each supported encoding name, keyed by the names lookup resolves aliases to.
+	encodings = map[string]Encoding{
+		"UTF-8":              NewTextEncoding(unicode.UTF8),
+		"IBM037":             NewTextEncoding(charmap.CodePage037),
+		"IBM437":             NewTextEncoding(charmap.CodePage437),
+		"IBM850":             NewTextEncoding(charmap.CodePage850),
+		"IBM852":             NewTextEncoding(charmap.CodePage852),
+		"IBM855":             NewTextEncoding(charmap.CodePage855),
+		"IBM866":             NewTextEncoding(charmap.CodePage866),
+		"ibm-858_P100-1997":  NewTextEncoding(charmap.CodePage858),
+		"ibm-860_P100-1995":  NewTextEncoding(charmap.CodePage860),
+		"ibm-862_P100-1995":  NewTextEncoding(charmap.CodePage862),
+		"ibm-863_P100-1995":  NewTextEncoding(charmap.CodePage863),
+		"ibm-865_P100-1995":  NewTextEncoding(charmap.CodePage865),
+		"ibm-1047_P100-1995": NewTextEncoding(charmap.CodePage1047),
+		"ibm-1140_P100-1997": NewTextEncoding(charmap.CodePage1140),
+		"ISO-8859-1":         NewTextEncoding(charmap.ISO8859_1),
+		"ISO-8859-2":         NewTextEncoding(charmap.ISO8859_2),
+		"ISO-8859-3":         NewTextEncoding(charmap.ISO8859_3),
+		"ISO-8859-4":         NewTextEncoding(charmap.ISO8859_4),
+		"ISO-8859-5":         NewTextEncoding(charmap.ISO8859_5),
+		"ISO-8859-6":         NewTextEncoding(charmap.ISO8859_6),
+		"ISO-8859-7":         NewTextEncoding(charmap.ISO8859_7),
+		"ISO-8859-8":         NewTextEncoding(charmap.ISO8859_8),
+		"ISO-8859-9":         NewTextEncoding(charmap.ISO8859_9),
+		"ISO-8859-10":        NewTextEncoding(charmap.ISO8859_10),
+		"ISO-8859-13":        NewTextEncoding(charmap.ISO8859_13),
+		"ISO-8859-14":        NewTextEncoding(charmap.ISO8859_14),
+		"ISO-8859-15":        NewTextEncoding(charmap.ISO8859_15),
+		"ISO-8859-16":        NewTextEncoding(charmap.ISO8859_16),
+		"KOI8-R":             NewTextEncoding(charmap.KOI8R),
+		"KOI8-U":             NewTextEncoding(charmap.KOI8U),
+		"macos-0_2-10.2":     NewTextEncoding(charmap.Macintosh),
+		"macos-7_3-10.2":     NewTextEncoding(charmap.MacintoshCyrillic),
+		"windows-874":        NewTextEncoding(charmap.Windows874),
+		"windows-1250":       NewTextEncoding(charmap.Windows1250),
+		"windows-1251":       NewTextEncoding(charmap.Windows1251),
+		"windows-1252":       NewTextEncoding(charmap.Windows1252),
+		"windows-1253":       NewTextEncoding(charmap.Windows1253),
+		"windows-1254":       NewTextEncoding(charmap.Windows1254),
+		"windows-1255":       NewTextEncoding(charmap.Windows1255),
+		"windows-1256":       NewTextEncoding(charmap.Windows1256),
+		"windows-1257":       NewTextEncoding(charmap.Windows1257),
+		"windows-1258":       NewTextEncoding(charmap.Windows1258),
+		"Shift_JIS":          NewTextEncoding(japanese.ShiftJIS),
+		"EUC-JP":             NewTextEncoding(japanese.EUCJP),
+		"EUC-KR":             NewTextEncoding(korean.EUCKR),
+		"GBK":                NewTextEncoding(simplifiedchinese.GBK),
+		"Big5":               NewTextEncoding(traditionalchinese.Big5),
+	}
+)
+
+// RegisterEncoding makes an Encoding available under the given name, to tables created or read with that name,
+// replacing any encoding of the same name. Names are matched exactly, before their aliases are resolved.
+func RegisterEncoding(name string, textEncoding Encoding) {
+	encodingsLock.Lock()
+	defer encodingsLock.Unlock()
+	encodings[name] = textEncoding
+}
+
+// encodingNamed returns the Encoding of the given name, alias or code page number, or an error matching
+// ErrUnsupportedEncoding if there is none.
+func encodingNamed(name string) (Encoding, error) {
+	encodingsLock.RLock()
+	textEncoding, found := encodings[name]
+	if !found {
+		textEncoding, found = encodings[canonicalEncoding(name)]
+	}
+	encodingsLock.RUnlock()
+	if found {
+		return textEncoding, nil
+	}
+
+	if ianaEncoding, ianaErr := ianaindex.IANA.Encoding(name); ianaErr == nil && ianaEncoding != nil {
+		return NewTextEncoding(ianaEncoding), nil
+	}
+	return nil, fmt.Errorf("encoding %q: %w", name, ErrUnsupportedEncoding)
+}
+
 var lookup map[string]string
-var encoding map[int]byte
-var encodingTable map[string]byte // this map is used to convert dbase file encodings to mahonia library encodings
 
 func init() {
 	lookup = make(map[string]string)
@@ -783,142 +956,4 @@ func init() {
 	lookup["csShiftJIS"] = "Shift_JIS"
 	lookup["SJIS"] = "Shift_JIS"
 	lookup["932"] = "Shift_JIS"
-
-	// dbase file encodings
-	// caution! I am not sure if this mapping are really true.
-	encoding = make(map[int]byte)
-	encoding[437] = 0x01   //DOS USA code page 437
-	encoding[850] = 0x02   // DOS Multilingual code page 850
-	encoding[1252] = 0x03  // Windows ANSI code page 1252
-	encoding[10000] = 0x04 // Standard Macintosh
-	encoding[865] = 0x08   // Danish OEM
-	encoding[437] = 0x09   // Dutch OEM
-	encoding[850] = 0x0A   // Dutch OEM Secondary codepage
-	encoding[437] = 0x0B   // Finnish OEM
-	encoding[437] = 0x0D   // French OEM
-	encoding[850] = 0x0E   // French OEM Secondary codepage
-	encoding[437] = 0x0F   // German OEM
-	encoding[850] = 0x10   // German OEM Secondary codepage
-	encoding[437] = 0x11   // Italian OEM
-	encoding[850] = 0x12   // Italian OEM Secondary codepage
-	encoding[932] = 0x13   // Japanese Shift-JIS
-	encoding[850] = 0x14   // Spanish OEM secondary codepage
-	encoding[437] = 0x15   // Swedish OEM
-	encoding[850] = 0x16   // Swedish OEM secondary codepage
-	encoding[865] = 0x17   // Norwegian OEM
-	encoding[437] = 0x18   // Spanish OEM
-	encoding[437] = 0x19   // English OEM (Britain)
-	encoding[850] = 0x1A   // English OEM (Britain) secondary codepage
-	encoding[437] = 0x1B   // English OEM (U.S.)
-	encoding[863] = 0x1C   // French OEM (Canada)
-	encoding[850] = 0x1D   // French OEM secondary codepage
-	encoding[852] = 0x1F   // Czech OEM
-	encoding[852] = 0x22   // Hungarian OEM
-	encoding[852] = 0x23   // Polish OEM
-	encoding[860] = 0x24   // Portuguese OEM
-	encoding[850] = 0x25   // Portuguese OEM secondary codepage
-	encoding[866] = 0x26   // Russian OEM
-	encoding[850] = 0x37   // English OEM (U.S.) secondary codepage
-	encoding[852] = 0x40   // Romanian OEM
-	encoding[936] = 0x4D   // Chinese GBK (PRC)
-	encoding[949] = 0x4E   // Korean (ANSI/OEM)
-	encoding[950] = 0x4F   // Chinese Big5 (Taiwan)
-	encoding[874] = 0x50   // Thai (ANSI/OEM)
-	encoding[1252] = 0x57  // ANSI
-	encoding[1252] = 0x58  // Western European ANSI
-	encoding[1252] = 0x59  // Spanish ANSI
-	encoding[852] = 0x64   // Eastern European MSDOS
-	encoding[866] = 0x65   // Russian MSDOS
-	encoding[865] = 0x66   // Nordic MSDOS
-	encoding[861] = 0x67   // Icelandic MSDOS
-	encoding[895] = 0x68   // Kamenicky (Czech) MS-DOS
-	encoding[620] = 0x69   // Mazovia (Polish) MS-DOS
-	encoding[737] = 0x6A   // Greek MSDOS (437G)
-	encoding[857] = 0x6B   // Turkish MSDOS
-	encoding[863] = 0x6C   // FrenchCanadian MSDOS
-	encoding[950] = 0x78   // Taiwan Big 5
-	encoding[949] = 0x79   // Hangul (Wansung)
-	encoding[936] = 0x7A   // PRC GBK
-	encoding[932] = 0x7B   // Japanese Shift-JIS
-	encoding[874] = 0x7C   // Thai Windows/MSDOS
-	encoding[1255] = 0x7D  // Hebrew Windows
-	encoding[1256] = 0x7E  // Arabic Windows
-	encoding[737] = 0x86   // Greek OEM
-	encoding[852] = 0x87   // Slovenian OEM
-	encoding[857] = 0x88   // Turkish OEM
-	encoding[10007] = 0x96 // Russian Macintosh
-	encoding[10029] = 0x97 // Eastern European Macintosh
-	encoding[10006] = 0x98 // Greek Macintosh
-	encoding[1250] = 0xC8  // Eastern European Windows
-	encoding[1251] = 0xC9  // Russian Windows
-	encoding[1254] = 0xCA  // Turkish Windows
-	encoding[1253] = 0xCB  // Greek Windows
-	encoding[1257] = 0xCC  // Baltic Windows
-
-	// cross mapping between dbase file encodings and mahonia library
-	encodingTable = make(map[string]byte)
-	encodingTable["IBM437"] = 0x1b
-	encodingTable["IBM850"] = 0x37
-	encodingTable["windows-1252"] = 0x59
-	//encodingTable[""] = 0x4
-	encodingTable["ibm-865_P100-1995"] = 0x66
-	encodingTable["IBM437"] = 0x1b
-	encodingTable["IBM850"] = 0x37
-	encodingTable["IBM437"] = 0x1b
-	encodingTable["IBM437"] = 0x1b
-	encodingTable["IBM850"] = 0x37
-	encodingTable["IBM437"] = 0x1b
-	encodingTable["IBM850"] = 0x37
-	encodingTable["IBM437"] = 0x1b
-	encodingTable["IBM850"] = 0x37
-	encodingTable["Shift_JIS"] = 0x7b
-	encodingTable["IBM850"] = 0x37
-	encodingTable["IBM437"] = 0x1b
-	encodingTable["IBM850"] = 0x37
-	encodingTable["ibm-865_P100-1995"] = 0x66
-	encodingTable["IBM437"] = 0x1b
-	encodingTable["IBM437"] = 0x1b
-	encodingTable["IBM850"] = 0x37
-	encodingTable["IBM437"] = 0x1b
-	encodingTable["ibm-863_P100-1995"] = 0x6c
-	encodingTable["IBM850"] = 0x37
-	encodingTable["IBM852"] = 0x87
-	encodingTable["IBM852"] = 0x87
-	encodingTable["IBM852"] = 0x87
-	encodingTable["ibm-860_P100-1995"] = 0x24
-	encodingTable["IBM850"] = 0x37
-	encodingTable["IBM866"] = 0x65
-	encodingTable["IBM850"] = 0x37
-	encodingTable["IBM852"] = 0x87
-	//encodingTable[""] = 0x7a
-	//encodingTable[""] = 0x79
-	encodingTable["Big5"] = 0x78
-	encodingTable["windows-874"] = 0x7c
-	encodingTable["windows-1252"] = 0x59
-	encodingTable["windows-1252"] = 0x59
-	encodingTable["IBM852"] = 0x87
-	encodingTable["IBM866"] = 0x65
-	encodingTable["ibm-865_P100-1995"] = 0x66
-	encodingTable["ibm-861_P100-1995"] = 0x67
-	//encodingTable[""] = 0x68
-	//encodingTable[""] = 0x69
-	//encodingTable[""] = 0x86
-	encodingTable["ibm-857_P100-1995"] = 0x88
-	encodingTable["ibm-863_P100-1995"] = 0x6c
-	encodingTable["Big5"] = 0x78
-	//encodingTable[""] = 0x79
-	//encodingTable[""] = 0x7a
-	encodingTable["Shift_JIS"] = 0x7b
-	encodingTable["windows-874"] = 0x7c
-	//encodingTable[""] = 0x86
-	encodingTable["IBM852"] = 0x87
-	encodingTable["ibm-857_P100-1995"] = 0x88
-	//encodingTable[""] = 0x96
-	//encodingTable[""] = 0x97
-	//encodingTable[""] = 0x98
-	encodingTable["windows-1250"] = 0xc8
-	encodingTable["windows-1251"] = 0xc9
-	encodingTable["windows-1254"] = 0xca
-	encodingTable["windows-1253"] = 0xcb
-	encodingTable["windows-1257"] = 0xcc
 }
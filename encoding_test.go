@@ -0,0 +1,220 @@
+package godbf
+
+import (
+	"bytes"
+	"errors"
+	. "github.com/onsi/gomega"
+	"strings"
+	"testing"
+)
+
+func newEncodingTestTable(encoding string) *DbfTable {
+	table := New(encoding)
+	table.AddTextField("NAME", 10)
+	table.AddMemoField("NOTES")
+	table.AddNewRecord()
+	return table
+}
+
+func TestDbfTable_SetFieldValue_UnmappableCharacters_Substituted(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newEncodingTestTable("windows-1252")
+
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "NAME", "café Ж")).To(Succeed())
+	g.Expect(tableUnderTest.FieldValueByName(0, "NAME")).To(Equal("café ?"))
+
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "NOTES", "Привет")).To(Succeed())
+	g.Expect(tableUnderTest.FieldValueByName(0, "NOTES")).To(Equal("??????"))
+}
+
+func TestDbfTable_SetStrictEncoding_UnmappableCharacters_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newEncodingTestTable("windows-1252")
+	tableUnderTest.AddNewRecord()
+	tableUnderTest.SetStrictEncoding(true)
+
+	g.Expect(tableUnderTest.SetFieldValueByName(1, "NAME", "café")).To(Succeed())
+
+	setErr := tableUnderTest.SetFieldValueByName(1, "NAME", "Ж")
+	t.Log(setErr)
+	g.Expect(errors.Is(setErr, ErrUnmappableCharacter)).To(BeTrue())
+
+	var encodingErr *EncodingError
+	g.Expect(errors.As(setErr, &encodingErr)).To(BeTrue())
+	g.Expect(encodingErr.Row).To(Equal(1))
+	g.Expect(encodingErr.FieldName).To(Equal("NAME"))
+	g.Expect(encodingErr.Encoding).To(Equal("windows-1252"))
+	g.Expect(tableUnderTest.FieldValueByName(1, "NAME")).To(Equal("café"))
+
+	memoErr := tableUnderTest.SetFieldValueByName(1, "NOTES", "Привет")
+	g.Expect(errors.As(memoErr, &encodingErr)).To(BeTrue())
+	g.Expect(encodingErr.FieldName).To(Equal("NOTES"))
+
+	g.Expect(errors.Is(tableUnderTest.SetMemoFieldValueByName(1, "NOTES", "Привет"), ErrUnmappableCharacter)).To(BeTrue())
+}
+
+func TestDbfTable_SetStrictEncoding_UndecodableContent_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newEncodingTestTable(testEncoding)
+	tableUnderTest.AddNewRecord()
+	fieldBytes, _ := tableUnderTest.fieldBytes(1, 0)
+	copy(fieldBytes, []byte{'a', 0xFF, 'b'})
+
+	g.Expect(tableUnderTest.FieldValueByName(1, "NAME")).To(Equal("a�b"))
+
+	tableUnderTest.SetStrictEncoding(true)
+
+	_, valueErr := tableUnderTest.FieldValueByName(1, "NAME")
+	t.Log(valueErr)
+	var encodingErr *EncodingError
+	g.Expect(errors.As(valueErr, &encodingErr)).To(BeTrue())
+	g.Expect(encodingErr.Row).To(Equal(1))
+	g.Expect(encodingErr.FieldName).To(Equal("NAME"))
+	g.Expect(errors.Is(valueErr, ErrUnmappableCharacter)).To(BeTrue())
+
+	g.Expect(tableUnderTest.FieldValue(1, 0)).To(Equal("a�b"))
+
+	record, _ := tableUnderTest.Record(1)
+	_, stringErr := record.String("NAME")
+	g.Expect(errors.Is(stringErr, ErrUnmappableCharacter)).To(BeTrue())
+
+	var target struct{ Name string }
+	g.Expect(errors.Is(tableUnderTest.Unmarshal(1, &target), ErrUnmappableCharacter)).To(BeTrue())
+
+	g.Expect(tableUnderTest.FieldValueByName(0, "NAME")).To(Equal(""))
+}
+
+func TestDbfTable_SetStrictEncoding_UnmappableFieldName_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New("windows-1252")
+	tableUnderTest.SetStrictEncoding(true)
+
+	addErr := tableUnderTest.AddTextField("ИМЯ", 10)
+
+	var encodingErr *EncodingError
+	g.Expect(errors.As(addErr, &encodingErr)).To(BeTrue())
+	g.Expect(encodingErr.Row).To(Equal(-1))
+	g.Expect(encodingErr.FieldName).To(Equal("ИМЯ"))
+	g.Expect(tableUnderTest.Fields()).To(BeEmpty())
+}
+
+func TestNew_UnsupportedEncoding_Utf8Used(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New("no-such-encoding")
+	g.Expect(tableUnderTest.TextEncoding()).To(Equal("UTF-8"))
+
+	tableUnderTest.AddTextField("NAME", 10)
+	tableUnderTest.AddNewRecord()
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "NAME", "ą")).To(Succeed())
+	g.Expect(tableUnderTest.fieldBytes(0, 0)).To(Equal([]byte("ą        ")))
+}
+
+func TestNewFromByteArray_UnsupportedEncoding_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var content bytes.Buffer
+	g.Expect(writeContent(newEncodingTestTable(testEncoding), &content)).To(Succeed())
+
+	_, loadErr := NewFromByteArray(content.Bytes(), "no-such-encoding")
+	g.Expect(errors.Is(loadErr, ErrUnsupportedEncoding)).To(BeTrue())
+}
+
+// reversingEncoding is an Encoding storing text reversed, to show a caller-supplied encoding is used.
+type reversingEncoding struct{}
+
+func (reversingEncoding) Encode(text string) ([]byte, error) {
+	return []byte(reverse(text)), nil
+}
+
+func (reversingEncoding) Decode(content []byte) (string, error) {
+	return reverse(string(content)), nil
+}
+
+func reverse(text string) string {
+	runes := []rune(text)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
+func TestRegisterEncoding_CallerSuppliedEncoding_Used(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	RegisterEncoding("x-reversing", reversingEncoding{})
+
+	tableUnderTest := New("x-reversing")
+	tableUnderTest.AddTextField("NAME", 10)
+	tableUnderTest.AddNewRecord()
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "NAME", "abc")).To(Succeed())
+
+	g.Expect(tableUnderTest.fieldBytes(0, 0)).To(Equal([]byte("cba       ")))
+	g.Expect(tableUnderTest.FieldValueByName(0, "NAME")).To(Equal("abc"))
+
+	var content bytes.Buffer
+	g.Expect(writeContent(tableUnderTest, &content)).To(Succeed())
+	g.Expect(content.Bytes()).To(ContainSubstring("EMAN"))
+
+	reloadedTable, loadErr := NewFromByteArray(content.Bytes(), "x-reversing")
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(reloadedTable.FieldNames()).To(Equal([]string{"NAME"}))
+	g.Expect(reloadedTable.FieldValueByName(0, "NAME")).To(Equal("abc"))
+}
+
+func TestEncodingNamed_LanguageDriverEncodings_RoundTrip(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	samples := map[string]string{
+		"IBM737":            "Καλημέρα",
+		"ibm-857_P100-1995": "Günaydın",
+		"ibm-861_P100-1995": "Góðan daginn",
+		"macos-6_2-10.4":    "Καλημέρα",
+		"macos-29-10.2":     "Dzień dobry",
+		"IBM866":            "Привет",
+		"GBK":               "你好",
+		"EUC-KR":            "안녕하세요",
+		"Shift_JIS":         "こんにちは",
+		"Big5":              "你好",
+		"IBM775":            "Labdien, ąčęėį",
+		"IBM500":            "Hello, world",
+		"ISO-8859-11":       "สวัสดี",
+		"macos-35-10.2":     "Günaydın",
+	}
+
+	for name, text := range samples {
+		encoding, encodingErr := encodingNamed(name)
+		g.Expect(encodingErr).To(BeNil(), name)
+
+		content, encodeErr := encoding.Encode(text)
+		g.Expect(encodeErr).To(BeNil(), name)
+		g.Expect(strings.ContainsRune(string(content), substituteByte)).To(BeFalse(), name)
+
+		g.Expect(encoding.Decode(content)).To(Equal(text), name)
+	}
+}
+
+func TestEncodingNamed_Aliases_Resolved(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	for _, name := range []string{"cp866", "866", "1252", "latin2", "ISO-8859-1", "UTF-8", "SJIS"} {
+		_, encodingErr := encodingNamed(name)
+		g.Expect(encodingErr).To(BeNil(), name)
+	}
+
+	_, encodingErr := encodingNamed("no-such-encoding")
+	g.Expect(errors.Is(encodingErr, ErrUnsupportedEncoding)).To(BeTrue())
+}
+
+func TestEncodingNamed_EveryLookupTarget_Resolved(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	for alias, name := range lookup {
+		_, encodingErr := encodingNamed(name)
+		g.Expect(encodingErr).To(BeNil(), alias)
+	}
+}
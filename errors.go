@@ -0,0 +1,136 @@
+package godbf
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors classifying the failures reported by this package, for use with errors.Is.
+var (
+	// ErrCorruptHeader is matched by every CorruptHeaderError.
+	ErrCorruptHeader = errors.New("table header is corrupt")
+	// ErrTruncated reports table content shorter than its header describes.
+	ErrTruncated = errors.New("table content is truncated")
+	// ErrBadEOFMarker reports table content that does not end with the end-of-file marker.
+	ErrBadEOFMarker = errors.New("table end-of-file marker is invalid")
+	// ErrInvalidFieldName reports a field descriptor whose name is missing its end-of-field marker.
+	ErrInvalidFieldName = errors.New("field name is invalid")
+	// ErrUnsupportedFieldType reports a field descriptor of a type this package cannot read or write.
+	ErrUnsupportedFieldType = errors.New("field type is not supported")
+	// ErrFieldNotFound is matched by every FieldNotFoundError.
+	ErrFieldNotFound = errors.New("field does not exist")
+	// ErrRowOutOfBounds reports a row number outside the records of a table.
+	ErrRowOutOfBounds = errors.New("row out of bounds")
+	// ErrReadOnly reports an attempt to change a table, or file, opened read-only.
+	ErrReadOnly = errors.New("table is read-only")
+	// ErrInvalidFieldValue is matched by every FieldValueError.
+	ErrInvalidFieldValue = errors.New("field value is invalid")
+	// ErrUnsupportedEncoding reports an encoding name that names no supported Encoding.
+	ErrUnsupportedEncoding = errors.New("encoding is not supported")
+	// ErrUnmappableCharacter reports text an encoding cannot represent, or content that decodes to no text.
+	ErrUnmappableCharacter = errors.New("character has no mapping in the encoding")
+)
+
+// CorruptHeaderError reports table content that does not match what its header describes, such as content of the
+// wrong length, or a malformed field descriptor. It matches ErrCorruptHeader, and the sentinel error in Err.
+type CorruptHeaderError struct {
+	Offset   int    // offset within the table content of the corrupt value
+	What     string // description of the corrupt value
+	Expected any    // the value expected, or nil if there is no single expected value
+	Actual   any    // the value found, or nil if Expected is nil
+	Err      error  // sentinel error further classifying the corruption, such as ErrTruncated, or nil
+}
+
+func (e *CorruptHeaderError) Error() string {
+	message := fmt.Sprintf("%s at offset %d", e.What, e.Offset)
+	if e.Expected != nil {
+		message += fmt.Sprintf(" is %v, but expected %v", e.Actual, e.Expected)
+	}
+	if e.Err != nil {
+		message += ": " + e.Err.Error()
+	}
+	return message
+}
+
+func (e *CorruptHeaderError) Unwrap() error {
+	return e.Err
+}
+
+func (e *CorruptHeaderError) Is(target error) bool {
+	return target == ErrCorruptHeader
+}
+
+// UnsupportedFieldTypeError reports a field descriptor of a type this package cannot read or write. It matches
+// ErrUnsupportedFieldType.
+type UnsupportedFieldTypeError struct {
+	FieldName string
+	FieldType DbaseDataType
+	Offset    int // offset within the table header of the field descriptor
+}
+
+func (e *UnsupportedFieldTypeError) Error() string {
+	return fmt.Sprintf("field \"%s\" at offset %d has unsupported type '%c' (0x%02X)", e.FieldName, e.Offset, byte(e.FieldType), byte(e.FieldType))
+}
+
+func (e *UnsupportedFieldTypeError) Is(target error) bool {
+	return target == ErrUnsupportedFieldType
+}
+
+// FieldNotFoundError reports a field name that no field of a table has. It matches ErrFieldNotFound.
+type FieldNotFoundError struct {
+	FieldName string
+}
+
+func (e *FieldNotFoundError) Error() string {
+	return "Field name \"" + e.FieldName + "\" does not exist"
+}
+
+func (e *FieldNotFoundError) Is(target error) bool {
+	return target == ErrFieldNotFound
+}
+
+// FieldValueError reports a value that cannot be stored in a field, being of the wrong form for the field's type, or
+// too large for the field. It matches ErrInvalidFieldValue, and the error in Err.
+type FieldValueError struct {
+	FieldName string
+	FieldType DbaseDataType
+	Value     string
+	Reason    string // description of why the value cannot be stored, or empty to use the message of Err
+	Err       error  // error raised parsing the value, or nil
+}
+
+func (e *FieldValueError) Error() string {
+	reason := e.Reason
+	if reason == "" && e.Err != nil {
+		reason = e.Err.Error()
+	}
+	return fmt.Sprintf("value %q is not valid for %s field \"%s\": %s", e.Value, e.FieldType.name(), e.FieldName, reason)
+}
+
+func (e *FieldValueError) Unwrap() error {
+	return e.Err
+}
+
+func (e *FieldValueError) Is(target error) bool {
+	return target == ErrInvalidFieldValue
+}
+
+// EncodingError reports text of a field that the table's encoding cannot represent, or content of a field that does
+// not decode to text, with strict encoding on. It matches ErrUnmappableCharacter.
+type EncodingError struct {
+	Row       int // row of the field's record, or -1 for a field's name
+	FieldName string
+	Encoding  string
+	Err       error // error raised by the encoding
+}
+
+func (e *EncodingError) Error() string {
+	if e.Row < 0 {
+		return fmt.Sprintf("name of field \"%s\" in encoding %s: %v", e.FieldName, e.Encoding, e.Err)
+	}
+	return fmt.Sprintf("row %d, field \"%s\" in encoding %s: %v", e.Row, e.FieldName, e.Encoding, e.Err)
+}
+
+func (e *EncodingError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,149 @@
+package godbf
+
+import (
+	"bytes"
+	"errors"
+	. "github.com/onsi/gomega"
+	"os"
+	"testing"
+)
+
+func TestNewFromByteArray_TruncatedContent_ErrTruncated(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawFileBytes, loadErr := os.ReadFile(validTestFile)
+	g.Expect(loadErr).To(BeNil())
+
+	_, byteArrayErr := NewFromByteArray(rawFileBytes[:len(rawFileBytes)-10], testEncoding)
+	t.Log(byteArrayErr)
+
+	g.Expect(errors.Is(byteArrayErr, ErrTruncated)).To(BeTrue())
+	g.Expect(errors.Is(byteArrayErr, ErrCorruptHeader)).To(BeTrue())
+
+	var headerErr *CorruptHeaderError
+	g.Expect(errors.As(byteArrayErr, &headerErr)).To(BeTrue())
+	g.Expect(headerErr.Expected).To(Equal(int64(len(rawFileBytes))))
+	g.Expect(headerErr.Actual).To(Equal(int64(len(rawFileBytes) - 10)))
+}
+
+func TestNewFromByteArray_BadEndOfFileMarker_ErrBadEOFMarker(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawFileBytes, loadErr := os.ReadFile(validTestFile)
+	g.Expect(loadErr).To(BeNil())
+	rawFileBytes[len(rawFileBytes)-1] = 0x00
+
+	_, byteArrayErr := NewFromByteArray(rawFileBytes, testEncoding)
+	t.Log(byteArrayErr)
+
+	g.Expect(errors.Is(byteArrayErr, ErrBadEOFMarker)).To(BeTrue())
+
+	var headerErr *CorruptHeaderError
+	g.Expect(errors.As(byteArrayErr, &headerErr)).To(BeTrue())
+	g.Expect(headerErr.Offset).To(Equal(len(rawFileBytes) - 1))
+}
+
+func TestNewFromByteArray_EndOfFieldMarkerMissing_ErrInvalidFieldName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawFileBytes, loadErr := os.ReadFile(validTestFile)
+	g.Expect(loadErr).To(BeNil())
+
+	const startByteOfFirstFieldName = 32
+	for i := startByteOfFirstFieldName; i <= startByteOfFirstFieldName+fieldNameByteLength; i++ {
+		rawFileBytes[i] = 0x41 // UTF-8 'A'
+	}
+
+	_, byteArrayErr := NewFromByteArray(rawFileBytes, testEncoding)
+
+	g.Expect(errors.Is(byteArrayErr, ErrInvalidFieldName)).To(BeTrue())
+	g.Expect(errors.Is(byteArrayErr, ErrCorruptHeader)).To(BeTrue())
+}
+
+func TestNewFromByteArray_UnknownFieldType_UnsupportedFieldTypeError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawFileBytes, loadErr := os.ReadFile(validTestFile)
+	g.Expect(loadErr).To(BeNil())
+
+	const typeByteOfFirstField = 32 + 11
+	rawFileBytes[typeByteOfFirstField] = 'Z'
+
+	_, byteArrayErr := NewFromByteArray(rawFileBytes, testEncoding)
+	t.Log(byteArrayErr)
+
+	g.Expect(errors.Is(byteArrayErr, ErrUnsupportedFieldType)).To(BeTrue())
+
+	var typeErr *UnsupportedFieldTypeError
+	g.Expect(errors.As(byteArrayErr, &typeErr)).To(BeTrue())
+	g.Expect(typeErr.FieldType).To(Equal(DbaseDataType('Z')))
+	g.Expect(typeErr.Offset).To(Equal(32))
+}
+
+func TestNewFromByteArray_ShortContent_ErrorsWithoutPanic(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawFileBytes, loadErr := os.ReadFile(validTestFile)
+	g.Expect(loadErr).To(BeNil())
+
+	for _, length := range []int{0, 1, 10, 31, 32, 33, 64} {
+		g.Expect(func() {
+			_, byteArrayErr := NewFromByteArray(rawFileBytes[:length], testEncoding)
+			g.Expect(byteArrayErr).To(Not(BeNil()))
+		}).To(Not(Panic()))
+	}
+
+	oversizedHeader := bytes.Clone(rawFileBytes[:64])
+	oversizedHeader[8], oversizedHeader[9] = 0xFF, 0xFF
+
+	_, byteArrayErr := NewFromByteArray(oversizedHeader, testEncoding)
+	g.Expect(errors.Is(byteArrayErr, ErrTruncated)).To(BeTrue())
+}
+
+func TestNewReader_TruncatedHeader_ErrTruncated(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawFileBytes, loadErr := os.ReadFile(validTestFile)
+	g.Expect(loadErr).To(BeNil())
+
+	_, readerErr := NewReader(bytes.NewReader(rawFileBytes[:40]), testEncoding)
+	t.Log(readerErr)
+
+	g.Expect(errors.Is(readerErr, ErrTruncated)).To(BeTrue())
+}
+
+func TestDbfTable_MissingField_ErrFieldNotFound(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromFile(validTestFile, testEncoding)
+
+	_, fieldErr := tableUnderTest.FieldValueByName(0, "NO_SUCH_FIELD")
+
+	g.Expect(errors.Is(fieldErr, ErrFieldNotFound)).To(BeTrue())
+
+	var notFoundErr *FieldNotFoundError
+	g.Expect(errors.As(fieldErr, &notFoundErr)).To(BeTrue())
+	g.Expect(notFoundErr.FieldName).To(Equal("NO_SUCH_FIELD"))
+}
+
+func TestDbfTable_RowOutOfBounds_ErrRowOutOfBounds(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromFile(validTestFile, testEncoding)
+
+	_, recordErr := tableUnderTest.Record(tableUnderTest.NumberOfRecords())
+
+	g.Expect(errors.Is(recordErr, ErrRowOutOfBounds)).To(BeTrue())
+}
+
+func TestDbfTable_OpenMapped_ErrReadOnly(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, openErr := OpenMapped(validTestFile, testEncoding)
+	g.Expect(openErr).To(BeNil())
+	defer tableUnderTest.Close()
+
+	setErr := tableUnderTest.SetFieldValue(0, 0, "changed")
+
+	g.Expect(errors.Is(setErr, ErrReadOnly)).To(BeTrue())
+}
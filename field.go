@@ -6,7 +6,11 @@ type FieldDescriptor struct {
 	fieldType     DbaseDataType
 	length        byte
 	decimalPlaces byte // Field decimal count in binary
-	fieldStore    [32]byte
+	flags         byte // Visual FoxPro field flags (system, nullable, binary)
+
+	productionIndexFlag    byte   // dBase 7 flag marking the field as having a tag in the production .MDX index
+	nextAutoincrementValue uint32 // dBase 7 value the next record added will be given in an Autoincrement field
+	fieldStore             [32]byte
 }
 
 // Name returns the column name of the field
@@ -42,12 +46,60 @@ const (
 	Date      DbaseDataType = 'D'
 	Numeric   DbaseDataType = 'N'
 	Float     DbaseDataType = 'F'
+	Memo      DbaseDataType = 'M'
+	General   DbaseDataType = 'G'
+	Picture   DbaseDataType = 'P'
+	Integer   DbaseDataType = 'I'
+	Currency  DbaseDataType = 'Y'
+	Double    DbaseDataType = 'B'
+	DateTime  DbaseDataType = 'T'
 )
 
+// DateFormat is the layout of the YYYYMMDD text stored in Date fields.
+const DateFormat = "20060102"
+
 func (ddt DbaseDataType) byte() byte {
 	return byte(ddt)
 }
 
+// name returns a human-readable name for the data type, for use in messages.
+func (ddt DbaseDataType) name() string {
+	switch ddt {
+	case Character:
+		return "Character"
+	case Logical:
+		return "Logical"
+	case Date:
+		return "Date"
+	case Numeric:
+		return "Numeric"
+	case Float:
+		return "Float"
+	case Memo:
+		return "Memo"
+	case General:
+		return "General"
+	case Picture:
+		return "Picture"
+	case Integer:
+		return "Integer"
+	case Currency:
+		return "Currency"
+	case Double:
+		return "Double"
+	case DateTime:
+		return "DateTime"
+	case Autoincrement:
+		return "Autoincrement"
+	case Level7Double:
+		return "Double"
+	case Timestamp:
+		return "Timestamp"
+	default:
+		return "type '" + string(ddt) + "'"
+	}
+}
+
 const notApplicable = 0x00
 
 // fixedFieldLength returns the length in bytes in for the data type if it describes a fixed-length field.
@@ -57,6 +109,12 @@ func (ddt DbaseDataType) fixedFieldLength() byte {
 		return 1
 	case Date:
 		return 8
+	case Memo, General, Picture:
+		return 10
+	case Integer, Autoincrement:
+		return 4
+	case Currency, Double, DateTime, Level7Double, Timestamp:
+		return 8
 	default:
 		return notApplicable
 	}
@@ -65,13 +123,42 @@ func (ddt DbaseDataType) fixedFieldLength() byte {
 // usesDecimalCount indicates whether the data type describes a field that makes use of a field's decimal count setting.
 func (ddt DbaseDataType) usesDecimalCount() bool {
 	switch ddt {
-	case Float, Numeric:
+	case Float, Numeric, Double:
 		return true
 	default:
 		return false
 	}
 }
 
+// isBinary indicates whether the data type describes a field whose content is stored as binary, rather than as text.
+func (ddt DbaseDataType) isBinary() bool {
+	switch ddt {
+	case Integer, Currency, Double, DateTime, Autoincrement, Level7Double, Timestamp:
+		return true
+	default:
+		return false
+	}
+}
+
+// usesMemo indicates whether the data type describes a field whose content is kept in the table's memo file, with
+// the field itself holding only a reference to that content.
+func (ddt DbaseDataType) usesMemo() bool {
+	switch ddt {
+	case Memo, General, Picture:
+		return true
+	default:
+		return false
+	}
+}
+
+// binaryMemoBlockType returns the MemoBlockType used to store binary content for a field of the data type.
+func (ddt DbaseDataType) binaryMemoBlockType() MemoBlockType {
+	if ddt == General {
+		return ObjectMemoBlock
+	}
+	return PictureMemoBlock
+}
+
 // decimalCountNotApplicable is a convenience decorator supplying a 0-valued byte. THis is used indicate that the data
 // type describes a field that does not make use its decimal count setting.
 func (ddt DbaseDataType) decimalCountNotApplicable() byte {
@@ -0,0 +1,118 @@
+package godbf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	fptFileExtension = ".fpt"
+
+	fptHeaderLength        = 512
+	fptDefaultBlockSize    = 64
+	fptNextFreeBlockOffset = 0
+	fptBlockSizeOffset     = 6
+
+	fptBlockHeaderLength = 8
+)
+
+// fptMemo is a memoStore for the FoxPro and Visual FoxPro .FPT memo file format.
+// For reference: https://learn.microsoft.com/en-us/previous-versions/visualstudio/foxpro/8599s21w(v=vs.80)
+//
+// The file is divided into blocks of a configurable size, following a 512-byte header holding the number of the next
+// free block and the block size. Each memo starts with an 8-byte block header of its MemoBlockType and its length
+// (excluding the block header). Unlike the dBase formats, FoxPro stores these integers in big-endian byte order.
+type fptMemo struct {
+	blockSize int
+	data      []byte
+}
+
+// newFptMemo creates an empty .FPT memo file using blocks of the specified size.
+func newFptMemo(blockSize uint16) *fptMemo {
+	m := &fptMemo{
+		blockSize: int(blockSize),
+		data:      make([]byte, fptHeaderLength),
+	}
+
+	binary.BigEndian.PutUint16(m.data[fptBlockSizeOffset:], blockSize)
+	m.setNextFreeBlock(m.firstFreeBlock())
+
+	return m
+}
+
+// newFptMemoFromBytes interprets the raw bytes of a .FPT memo file.
+func newFptMemoFromBytes(data []byte) (*fptMemo, error) {
+	if len(data) < fptHeaderLength {
+		return nil, fmt.Errorf("memo file is %d bytes, too short to hold a memo file header", len(data))
+	}
+
+	blockSize := binary.BigEndian.Uint16(data[fptBlockSizeOffset:])
+	if blockSize == 0 {
+		return nil, fmt.Errorf("memo file header declares a block size of 0")
+	}
+
+	return &fptMemo{blockSize: int(blockSize), data: data}, nil
+}
+
+func (m *fptMemo) nextFreeBlock() uint32 {
+	return binary.BigEndian.Uint32(m.data[fptNextFreeBlockOffset:])
+}
+
+func (m *fptMemo) setNextFreeBlock(block uint32) {
+	binary.BigEndian.PutUint32(m.data[fptNextFreeBlockOffset:], block)
+}
+
+func (m *fptMemo) readMemo(block uint32) ([]byte, MemoBlockType, error) {
+	start := int(block) * m.blockSize
+	if start < fptHeaderLength || start+fptBlockHeaderLength > len(m.data) {
+		return nil, TextMemoBlock, fmt.Errorf("memo block %d lies outside the memo file", block)
+	}
+
+	blockType := MemoBlockType(binary.BigEndian.Uint32(m.data[start:]))
+	length := int(binary.BigEndian.Uint32(m.data[start+4:]))
+
+	contentStart := start + fptBlockHeaderLength
+	if length > len(m.data)-contentStart {
+		return nil, blockType, fmt.Errorf("memo block %d declares a length of %d bytes, but only %d remain", block, length, len(m.data)-contentStart)
+	}
+
+	return bytes.Clone(m.data[contentStart : contentStart+length]), blockType, nil
+}
+
+func (m *fptMemo) writeMemo(content []byte, blockType MemoBlockType) (uint32, error) {
+	entry := binary.BigEndian.AppendUint32(nil, uint32(blockType))
+	entry = binary.BigEndian.AppendUint32(entry, uint32(len(content)))
+	entry = append(entry, content...)
+
+	blocksUsed := (len(entry) + m.blockSize - 1) / m.blockSize
+	entry = append(entry, make([]byte, blocksUsed*m.blockSize-len(entry))...)
+
+	// a next free block in the header, or below the end of the file, would overwrite it
+	block := max(m.nextFreeBlock(), m.firstFreeBlock(), uint32((len(m.data)+m.blockSize-1)/m.blockSize))
+	start := int(block) * m.blockSize
+	m.data = append(m.data, make([]byte, start-len(m.data))...)
+	m.data = append(m.data, entry...)
+	m.setNextFreeBlock(block + uint32(blocksUsed))
+
+	return block, nil
+}
+
+func (m *fptMemo) bytes() []byte {
+	return m.data
+}
+
+func (m *fptMemo) empty() memoStore {
+	e := &fptMemo{blockSize: m.blockSize, data: bytes.Clone(m.data[:fptHeaderLength])}
+	e.setNextFreeBlock(m.firstFreeBlock())
+	return e
+}
+
+// firstFreeBlock returns the number of the first block following the 512-byte header.
+func (m *fptMemo) firstFreeBlock() uint32 {
+	return uint32((fptHeaderLength + m.blockSize - 1) / m.blockSize)
+}
+
+func (m *fptMemo) fileExtension() string {
+	return fptFileExtension
+}
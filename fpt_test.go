@@ -0,0 +1,98 @@
+package godbf
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestFptMemo_New(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	memoUnderTest := newFptMemo(64)
+
+	g.Expect(len(memoUnderTest.bytes())).To(Equal(fptHeaderLength))
+	g.Expect(memoUnderTest.bytes()[6:8]).To(Equal([]byte{0x00, 0x40}))
+	g.Expect(memoUnderTest.nextFreeBlock()).To(BeEquivalentTo(8))
+	g.Expect(memoUnderTest.fileExtension()).To(Equal(".fpt"))
+}
+
+func TestFptMemo_WriteMemo_BlockLayout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	memoUnderTest := newFptMemo(16)
+	content := []byte("twelve bytes")
+
+	block, writeErr := memoUnderTest.writeMemo(content, TextMemoBlock)
+	g.Expect(writeErr).To(BeNil())
+	g.Expect(block).To(BeEquivalentTo(32))
+
+	start := int(block) * 16
+	g.Expect(memoUnderTest.bytes()[start : start+8]).To(Equal([]byte{0, 0, 0, 1, 0, 0, 0, 12}))
+	g.Expect(len(memoUnderTest.bytes())).To(Equal(start + 32))
+	g.Expect(memoUnderTest.nextFreeBlock()).To(BeEquivalentTo(34))
+}
+
+func TestFptMemo_ReadMemo_RoundTrips(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	memoUnderTest := newFptMemo(512)
+	firstBlock, _ := memoUnderTest.writeMemo([]byte("text"), TextMemoBlock)
+	secondBlock, _ := memoUnderTest.writeMemo([]byte{0xFF, 0x00}, ObjectMemoBlock)
+
+	reloadedMemo, loadErr := newFptMemoFromBytes(memoUnderTest.bytes())
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(reloadedMemo.blockSize).To(Equal(512))
+
+	content, blockType, readErr := reloadedMemo.readMemo(firstBlock)
+	g.Expect(readErr).To(BeNil())
+	g.Expect(content).To(Equal([]byte("text")))
+	g.Expect(blockType).To(Equal(TextMemoBlock))
+
+	content, blockType, readErr = reloadedMemo.readMemo(secondBlock)
+	g.Expect(readErr).To(BeNil())
+	g.Expect(content).To(Equal([]byte{0xFF, 0x00}))
+	g.Expect(blockType).To(Equal(ObjectMemoBlock))
+}
+
+func TestFptMemo_WriteMemo_StaleNextFreeBlock_EarlierMemosKept(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	memoUnderTest := newFptMemo(64)
+	firstBlock, _ := memoUnderTest.writeMemo([]byte("first"), TextMemoBlock)
+	secondBlock, _ := memoUnderTest.writeMemo([]byte("second"), PictureMemoBlock)
+	header := bytes.Clone(memoUnderTest.bytes()[4:fptHeaderLength])
+
+	for _, staleBlock := range []uint32{firstBlock, 0} {
+		memoUnderTest.setNextFreeBlock(staleBlock)
+		thirdBlock, writeErr := memoUnderTest.writeMemo([]byte("third"), TextMemoBlock)
+		g.Expect(writeErr).To(BeNil())
+		g.Expect(thirdBlock).To(BeNumerically(">", secondBlock))
+
+		for block, expected := range map[uint32]string{firstBlock: "first", secondBlock: "second", thirdBlock: "third"} {
+			content, _, readErr := memoUnderTest.readMemo(block)
+			g.Expect(readErr).To(BeNil())
+			g.Expect(string(content)).To(Equal(expected))
+		}
+		g.Expect(memoUnderTest.bytes()[4:fptHeaderLength]).To(Equal(header))
+	}
+}
+
+func TestFptMemo_ReadMemo_OutsideFile_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	memoUnderTest := newFptMemo(64)
+
+	_, _, readErr := memoUnderTest.readMemo(100)
+	g.Expect(readErr).ToNot(BeNil())
+	t.Log(readErr)
+}
+
+func TestNewFptMemoFromBytes_TooShort_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, loadErr := newFptMemoFromBytes(make([]byte, 10))
+	g.Expect(loadErr).ToNot(BeNil())
+	t.Log(loadErr)
+}
@@ -4,13 +4,12 @@ module github.com/LindsayBradford/go-dbf
 
 go 1.23
 require (
-	github.com/axgle/mahonia v0.0.0-20180208002826-3358181d7394
 	github.com/onsi/gomega v1.36.1
+	golang.org/x/text v0.22.0
 )
 
 require (
 	github.com/google/go-cmp v0.6.0 // indirect
 	golang.org/x/net v0.36.0 // indirect
-	golang.org/x/text v0.22.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -3,6 +3,8 @@ package godbf
 import (
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 )
 
 type readerFunction func(r io.Reader, buf []byte) (int, error)
@@ -14,7 +16,11 @@ var reader readerFunction = io.ReadFull
 type fileSystem interface {
 	Create(name string) (*os.File, error)
 	Open(name string) (file, error)
+	OpenFile(name string, flag int, perm os.FileMode) (file, error)
 	Stat(name string) (os.FileInfo, error)
+	Rename(oldName string, newName string) error
+	Link(oldName string, newName string) error
+	Remove(name string) error
 }
 
 // osFileSystem implements fileSystem using the local disk.
@@ -23,6 +29,12 @@ type osFileSystem struct{}
 func (osFileSystem) Create(name string) (*os.File, error)  { return os.Create(name) }
 func (osFileSystem) Open(name string) (file, error)        { return os.Open(name) }
 func (osFileSystem) Stat(name string) (os.FileInfo, error) { return os.Stat(name) }
+func (osFileSystem) OpenFile(name string, flag int, perm os.FileMode) (file, error) {
+	return os.OpenFile(name, flag, perm)
+}
+func (osFileSystem) Rename(oldName string, newName string) error { return os.Rename(oldName, newName) }
+func (osFileSystem) Link(oldName string, newName string) error   { return os.Link(oldName, newName) }
+func (osFileSystem) Remove(name string) error                    { return os.Remove(name) }
 
 var fsWrapper fileSystem = osFileSystem{}
 
@@ -30,10 +42,13 @@ type file interface {
 	io.Closer
 	io.Reader
 	io.ReaderAt
+	io.Writer
+	io.WriterAt
 	io.Seeker
 	Stat() (os.FileInfo, error)
 }
 
+// readFile reads the whole content of the file with the given name.
 func readFile(filename string) ([]byte, error) {
 	f, err := fsWrapper.Open(filename)
 	if err != nil {
@@ -62,3 +77,35 @@ func uint32ToBytes(x uint32) []byte {
 	buf[3] = byte(x >> 24)
 	return buf[:]
 }
+
+// companionFileName returns the name of the companion file (memo, etc.) for the table of the given file name, using
+// the supplied extension in the same letter case as the table file's extension.
+func companionFileName(tableFileName string, extension string) string {
+	tableExtension := filepath.Ext(tableFileName)
+	if tableExtension != "" && tableExtension == strings.ToUpper(tableExtension) {
+		extension = strings.ToUpper(extension)
+	} else {
+		extension = strings.ToLower(extension)
+	}
+	return strings.TrimSuffix(tableFileName, tableExtension) + extension
+}
+
+// findCompanionFile returns the name of an existing companion file for the table of the given file name, trying the
+// supplied extension in both the table file extension's letter case and its opposite.
+func findCompanionFile(tableFileName string, extension string) (string, error) {
+	preferredName := companionFileName(tableFileName, extension)
+	if _, statErr := fsWrapper.Stat(preferredName); statErr == nil {
+		return preferredName, nil
+	}
+
+	alternateName := strings.TrimSuffix(preferredName, filepath.Ext(preferredName)) + strings.ToUpper(extension)
+	if alternateName == preferredName {
+		alternateName = strings.TrimSuffix(preferredName, filepath.Ext(preferredName)) + strings.ToLower(extension)
+	}
+
+	_, statErr := fsWrapper.Stat(alternateName)
+	if statErr != nil {
+		return "", statErr
+	}
+	return alternateName, nil
+}
@@ -2,40 +2,94 @@ package godbf
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 )
 
 // NewFromByteArray creates a DbfTable, reading it from a raw byte array, expecting the supplied encoding.
+// Passing AutoEncoding, or AutoEncodingWithDefault, derives the encoding from the language driver ID in its header.
+// If the content does not match what its header describes, a CorruptHeaderError is returned, and if it has fields of
+// a type that is not supported, an UnsupportedFieldTypeError.
 func NewFromByteArray(data []byte, fileEncoding string) (table *DbfTable, newErr error) {
-	defer func() {
-		if e := recover(); e != nil {
-			newErr = fmt.Errorf("%v", e)
-		}
-	}()
-
 	dt := new(DbfTable)
-	dt.UseEncoding(fileEncoding)
-	unpackHeader(data, dt)
+	if encodingErr := dt.useFileEncoding(fileEncoding, data); encodingErr != nil {
+		return nil, encodingErr
+	}
+	if headerErr := unpackHeader(data, dt); headerErr != nil {
+		return nil, headerErr
+	}
+	if sizeErr := verifyByteArraySizeAgainstExpected(data, dt); sizeErr != nil {
+		return nil, sizeErr
+	}
+
 	unpackRecords(data, dt)
 	unpackFooter(data, dt)
-
-	verifyTableAgainstRawBytes(data, dt)
+	if footerErr := verifyTableAgainstRawFooter(data, dt); footerErr != nil {
+		return nil, footerErr
+	}
 
 	lockSchema(dt)
 	return dt, nil
 }
 
+// NewFromByteArrayWithMemo creates a DbfTable, reading it from a raw byte array, and the content of its memo fields
+// from the raw byte array of its companion memo file, expecting the supplied encoding.
+func NewFromByteArrayWithMemo(data []byte, memoData []byte, fileEncoding string) (table *DbfTable, newErr error) {
+	dt, newErr := NewFromByteArray(data, fileEncoding)
+	if newErr != nil {
+		return nil, newErr
+	}
+
+	if memoErr := dt.attachMemo(memoData); memoErr != nil {
+		return nil, memoErr
+	}
+
+	return dt, nil
+}
+
 func unpackHeader(s []byte, dt *DbfTable) error {
+	if len(s) < tableHeaderPrefixLength {
+		return &CorruptHeaderError{What: "content length", Expected: tableHeaderPrefixLength, Actual: len(s), Err: ErrTruncated}
+	}
+
 	dt.fileSignature = s[0]
 	dt.SetLastUpdatedFromBytes(s[1:4])
 	dt.SetNumberOfRecordsFromBytes(s[4:8])
 	dt.SetNumberOfBytesInHeaderFromBytes(s[8:10])
 	dt.SetLengthOfEachRecordFromBytes(s[10:12])
 
-	if fieldErr := unpackFields(s, dt); fieldErr != nil {
+	if int(dt.numberOfBytesInHeader) < dt.headerPrefixLength() {
+		return &CorruptHeaderError{Offset: 8, What: "header length", Expected: dt.headerPrefixLength(), Actual: dt.numberOfBytesInHeader}
+	}
+	if int(dt.numberOfBytesInHeader) > len(s) {
+		return &CorruptHeaderError{What: "content length", Expected: dt.numberOfBytesInHeader, Actual: len(s), Err: ErrTruncated}
+	}
+
+	var fieldErr error
+	if dt.isDbase7() {
+		fieldErr = unpackLevel7Fields(s, dt)
+	} else {
+		fieldErr = unpackFields(s, dt)
+	}
+	if fieldErr != nil {
 		return fieldErr
 	}
+
+	return verifyRecordLength(dt)
+}
+
+// verifyRecordLength checks the fields of the table fit within the record length its header declares.
+func verifyRecordLength(dt *DbfTable) error {
+	fieldsLength := 1 // deletion flag
+	for _, field := range dt.fields {
+		fieldsLength += int(field.length)
+	}
+	if dt.nullFlagsField != nil {
+		fieldsLength = max(fieldsLength, dt.nullFlagsRecordOffset+int(dt.nullFlagsField.length))
+	}
+
+	if fieldsLength > int(dt.lengthOfEachRecord) {
+		return &CorruptHeaderError{Offset: 10, What: "record length", Expected: fieldsLength, Actual: dt.lengthOfEachRecord}
+	}
 	return nil
 }
 
@@ -43,20 +97,35 @@ func unpackFields(s []byte, dt *DbfTable) error {
 	// create fieldMap to translate field name to index
 	dt.fieldMap = make(map[string]int)
 
-	// Number of fields in dbase table
-	dt.numberOfFields = int((dt.numberOfBytesInHeader - 1 - 32) / 32)
-	for i := 0; i < dt.numberOfFields; i++ {
-		if unpackFieldErr := unpackField(s, dt, i); unpackFieldErr != nil {
-			return unpackFieldErr
+	// Field descriptors follow the 32-byte table header, up to the field descriptor array terminator.
+	dt.numberOfFields = 0
+	recordOffset := 1
+	offset := 32
+	for ; offset+32 <= int(dt.numberOfBytesInHeader) && s[offset] != fieldDescriptorArrayTerminator; offset += 32 {
+		if s[offset+11] == NullFlags.byte() && s[offset+fieldFlagsOffset]&systemFieldFlag != 0 {
+			if nullFlagsErr := unpackNullFlagsField(s, dt, offset, recordOffset); nullFlagsErr != nil {
+				return nullFlagsErr
+			}
+		} else {
+			if unpackFieldErr := unpackField(s, dt, dt.numberOfFields, offset); unpackFieldErr != nil {
+				return unpackFieldErr
+			}
+			dt.numberOfFields++
 		}
+		recordOffset += int(s[offset+16])
+	}
+
+	if dt.isVisualFoxPro() {
+		unpackBacklink(s, dt, offset)
 	}
 	return nil
 }
 
-func unpackField(s []byte, dt *DbfTable, fieldIndex int) error {
-	offset := (fieldIndex * 32) + 32
-
-	fieldName := deriveFieldName(s, dt, offset)
+func unpackField(s []byte, dt *DbfTable, fieldIndex int, offset int) error {
+	fieldName, nameErr := deriveFieldName(s, dt, offset)
+	if nameErr != nil {
+		return nameErr
+	}
 
 	dt.fieldMap[fieldName] = fieldIndex
 
@@ -73,27 +142,51 @@ func unpackField(s []byte, dt *DbfTable, fieldIndex int) error {
 		unpackErr = dt.AddBooleanField(fieldName)
 	case 'D':
 		unpackErr = dt.AddDateField(fieldName)
+	case 'I', 'Y', 'B', 'T':
+		binaryType := DbaseDataType(s[offset+11])
+		unpackErr = dt.addField(fieldName, binaryType, s[offset+16], s[offset+17])
+	case 'M', 'G', 'P':
+		memoType := DbaseDataType(s[offset+11])
+		unpackErr = dt.addField(fieldName, memoType, s[offset+16], memoType.decimalCountNotApplicable())
+	default:
+		unpackErr = &UnsupportedFieldTypeError{FieldName: fieldName, FieldType: DbaseDataType(s[offset+11]), Offset: offset}
 	}
 
 	if unpackErr != nil {
 		return unpackErr
 	}
 
+	if lastField := len(dt.fields) - 1; lastField == fieldIndex {
+		dt.fields[lastField].flags = s[offset+fieldFlagsOffset]
+		dt.fields[lastField].fieldStore[fieldFlagsOffset] = s[offset+fieldFlagsOffset]
+		return verifyFieldLength(dt, dt.fields[lastField], offset+16)
+	}
+
 	return nil
 }
 
-func deriveFieldName(s []byte, dt *DbfTable, offset int) string {
+// verifyFieldLength checks a binary field has the fixed length its encoding requires, reporting the offset of its
+// field descriptor's length byte if not.
+func verifyFieldLength(dt *DbfTable, fd FieldDescriptor, lengthOffset int) error {
+	if !dt.storesBinary(fd) || fd.length == fd.fieldType.fixedFieldLength() {
+		return nil
+	}
+	what := fmt.Sprintf("length of field \"%s\"", fd.name)
+	return &CorruptHeaderError{Offset: lengthOffset, What: what, Expected: fd.fieldType.fixedFieldLength(), Actual: fd.length}
+}
+
+func deriveFieldName(s []byte, dt *DbfTable, offset int) (string, error) {
 	nameBytes := s[offset : offset+fieldNameByteLength]
 
 	// Max usable field length is 10 bytes, where the 11th should contain the end of field marker.
 	endOfFieldIndex := bytes.Index(nameBytes, []byte{endOfFieldNameMarker})
 	if endOfFieldIndex == -1 {
-		msg := fmt.Sprintf("end-of-field marker missing from field bytes, offset [%d,%d]", offset, offset+fieldNameByteLength)
-		panic(errors.New(msg))
+		what := fmt.Sprintf("end-of-field marker missing from field bytes [%d,%d]", offset, offset+fieldNameByteLength)
+		return "", &CorruptHeaderError{Offset: offset, What: what, Err: ErrInvalidFieldName}
 	}
 
-	fieldName := dt.decoder.ConvertString(string(nameBytes[:endOfFieldIndex]))
-	return fieldName
+	fieldName, _ := dt.textCodec().Decode(nameBytes[:endOfFieldIndex])
+	return fieldName, nil
 }
 
 func unpackRecords(data []byte, dt *DbfTable) {
@@ -104,27 +197,27 @@ func unpackFooter(data []byte, dt *DbfTable) {
 	dt.eofMarker = data[len(data)-1]
 }
 
-func verifyTableAgainstRawBytes(s []byte, dt *DbfTable) {
-	verifyTableAgainstRawHeader(s, dt)
-	verifyTableAgainstRawFooter(s, dt)
-}
-
-func verifyTableAgainstRawFooter(s []byte, dt *DbfTable) {
+func verifyTableAgainstRawFooter(s []byte, dt *DbfTable) error {
 	if dt.eofMarker != eofMarker {
-		panic(fmt.Errorf("encoded footer is %v, but actual footer is %d", eofMarker, s[len(s)-1]))
+		return &CorruptHeaderError{Offset: len(s) - 1, What: "end-of-file marker", Expected: eofMarker, Actual: s[len(s)-1], Err: ErrBadEOFMarker}
 	}
+	return nil
 }
 
-func verifyTableAgainstRawHeader(s []byte, dt *DbfTable) {
-	verifyByteArraySizeAgainstExpected(s, dt)
+func verifyByteArraySizeAgainstExpected(s []byte, dt *DbfTable) error {
+	expectedSize := int64(dt.numberOfBytesInHeader) + int64(dt.numberOfRecords)*int64(dt.lengthOfEachRecord) + 1
+	return verifyContentLength(int64(len(s)), expectedSize)
 }
 
-func verifyByteArraySizeAgainstExpected(s []byte, dt *DbfTable) {
-	expectedSize := uint32(dt.numberOfBytesInHeader) + dt.numberOfRecords*uint32(dt.lengthOfEachRecord) + 1
-	actualSize := uint32(len(s))
-	if actualSize != expectedSize {
-		panic(fmt.Errorf("encoded content is %d bytes, but header expected %d", actualSize, expectedSize))
+// verifyContentLength checks table content of the given length has the length its header expects.
+func verifyContentLength(actualSize int64, expectedSize int64) error {
+	switch {
+	case actualSize < expectedSize:
+		return &CorruptHeaderError{What: "content length", Expected: expectedSize, Actual: actualSize, Err: ErrTruncated}
+	case actualSize > expectedSize:
+		return &CorruptHeaderError{What: "content length", Expected: expectedSize, Actual: actualSize}
 	}
+	return nil
 }
 
 func lockSchema(dt *DbfTable) {
@@ -132,17 +225,27 @@ func lockSchema(dt *DbfTable) {
 }
 
 // New creates a new dbase table from scratch for the given character encoding
+// If the encoding is not supported, the table's text is read and written as UTF-8, the encoding TextEncoding then
+// reports. The language driver ID identifying the encoding is written to the header, or 0 if none does, as for UTF-8.
 func New(encoding string) (table *DbfTable) {
+	return newTable(encoding, dbaseIIIFileSignature, 32)
+}
+
+// newTable creates a new table from scratch with the given file signature, and a header of the given length
+// preceding its field descriptors.
+func newTable(encoding string, fileSignature byte, headerPrefixLength uint16) (table *DbfTable) {
 	dt := new(DbfTable)
 
 	// read dbase table header information
-	dt.fileSignature = 0x03
+	dt.fileSignature = fileSignature
 	dt.RefreshLastUpdated()
 	dt.numberOfRecords = 0
-	dt.numberOfBytesInHeader = 32
+	dt.numberOfBytesInHeader = headerPrefixLength
 	dt.lengthOfEachRecord = 0
 
-	dt.UseEncoding(encoding)
+	if encodingErr := dt.UseEncoding(resolveEncoding(encoding, 0)); encodingErr != nil {
+		dt.UseEncoding("UTF-8")
+	}
 	dt.createdFromScratch = true
 	// create fieldMap to translate field name to index
 	dt.fieldMap = make(map[string]int)
@@ -169,18 +272,15 @@ func New(encoding string) (table *DbfTable) {
 	// Huston we have problem!
 	// There is no easy way to deal with encoding issues. At least at the moment
 	// I will try to find archaic encoding code defined by dbase standard (if there is any)
-	// for given encoding. If none match I will go with default ANSI.
+	// for given encoding. If none match I will leave it 0, so as not to claim one.
 	//
 	// Despite this flag in set in dbase file, I will continue to use provide encoding for
 	// everything except this file encoding flag.
 	//
 	// Why? To make sure at least if you know the real encoding you can process text accordingly.
 
-	if code, ok := encodingTable[lookup[encoding]]; ok {
-		dt.dataStore[29] = code
-	} else {
-		dt.dataStore[29] = 0x57 // ANSI
-	}
+	languageDriver, _ := LanguageDriverForEncoding(dt.textEncoding)
+	dt.dataStore[languageDriverOffset] = byte(languageDriver)
 
 	dt.updateDataStore()
 
@@ -1,58 +1,122 @@
 // Package godbf offers functionality for loading and saving  "dBASE Version 5" dbf formatted files.
 // (https://en.wikipedia.org/wiki/.dbf#File_format_of_Level_5_DOS_dBASE) file structure.
 // For the definitive source, see http://www.dbase.com/manuals/57LanguageReference.zip
+//
+// dBASE 7 (Level 7) tables (https://www.dbase.com/Knowledgebase/INT/db7_file_fmt.htm) and FoxPro/Visual FoxPro
+// tables, along with their .DBT and .FPT memo files, are also supported.
 package godbf
 
 import (
 	"fmt"
-	"os"
+	"io"
 )
 
 // NewFromFile creates a DbfTable, reading it from a file with the given file name, expecting the supplied encoding.
+// Passing AutoEncoding, or AutoEncodingWithDefault, derives the encoding from the table's .cpg file, if it has one,
+// or otherwise from the language driver ID in its header.
 func NewFromFile(fileName string, fileEncoding string) (table *DbfTable, newErr error) {
-	defer func() {
-		if e := recover(); e != nil {
-			newErr = fmt.Errorf("%v", e)
-		}
-	}()
-
 	data, readErr := readFile(fileName)
 	if readErr != nil {
 		return nil, readErr
 	}
-	return NewFromByteArray(data, fileEncoding)
+
+	dt, newErr := NewFromByteArray(data, withCodePageFile(fileName, fileEncoding))
+	if newErr != nil {
+		return nil, newErr
+	}
+
+	if memoErr := loadMemoFile(dt, fileName); memoErr != nil {
+		return nil, memoErr
+	}
+
+	return dt, nil
 }
 
-// SaveToFile saves the supplied DbfTable to a file of the specified filename
-func SaveToFile(dt *DbfTable, filename string) (saveErr error) {
-	defer func() {
-		if e := recover(); e != nil {
-			saveErr = fmt.Errorf("%v", e)
-		}
-	}()
+// loadMemoFile reads the companion memo file of the table with the given file name, if the table has memo fields.
+func loadMemoFile(dt *DbfTable, fileName string) error {
+	if !dt.hasMemoFields() {
+		return nil
+	}
 
-	f, createErr := fsWrapper.Create(filename)
-	if createErr != nil {
-		return createErr
+	memoFileName, findErr := findCompanionFile(fileName, memoFileExtension(dt.fileSignature))
+	if findErr != nil {
+		return fmt.Errorf("memo file for table %q not found: %w", fileName, findErr)
 	}
 
-	defer func() {
-		if closeErr := f.Close(); closeErr != nil {
-			saveErr = closeErr
-		}
-	}()
+	memoData, readErr := readFile(memoFileName)
+	if readErr != nil {
+		return readErr
+	}
+
+	return dt.attachMemo(memoData)
+}
 
-	writeErr := writeContent(dt, f)
-	if writeErr != nil {
-		return writeErr
+// SaveToFile saves the supplied DbfTable to a file of the specified filename, along with its memo file, if it has
+// memo fields.
+//
+// Each file is first written to a temporary file in the same directory, and synced to disk, before replacing the
+// file of the same name. Should saving fail, the previously saved files are left intact.
+//
+// The memo file is replaced before the table file. Should replacing the table file fail, the memo file is rolled
+// back. Until the table file is replaced, the previous memo file is kept alongside it, as its .tbk backup with
+// WithBackup, or otherwise under its name followed by a random suffix and .old. Should the process crash between the
+// two, restore the previous memo file from it: a packed table's memo file is rebuilt, so the previous table's memos
+// may no longer be where it expects them.
+func SaveToFile(dt *DbfTable, filename string, options ...SaveOption) error {
+	var contents []savedFile
+	if dt.memo != nil {
+		contents = append(contents, memoSavedFile(dt, filename))
 	}
+	contents = append(contents, savedFile{
+		name:       filename,
+		backupName: companionFileName(filename, tableBackupFileExtension),
+		write:      func(f io.Writer) error { return writeContent(dt, f) },
+	})
+
+	return saveFiles(contents, options...)
+}
 
-	return saveErr
+// saveMemoFile saves the memo file of the supplied DbfTable as a companion to the table file of the given name.
+func saveMemoFile(dt *DbfTable, filename string) error {
+	if dt.memo == nil {
+		return nil
+	}
+	return saveFiles([]savedFile{memoSavedFile(dt, filename)})
+}
+
+func memoSavedFile(dt *DbfTable, filename string) savedFile {
+	return savedFile{
+		name:       companionFileName(filename, dt.memo.fileExtension()),
+		backupName: companionFileName(filename, memoBackupFileExtension),
+		write: func(f io.Writer) error {
+			_, writeErr := f.Write(dt.memo.bytes())
+			return writeErr
+		},
+	}
 }
 
-func writeContent(dt *DbfTable, f *os.File) error {
-	if _, dsErr := f.Write(dt.dataStore); dsErr != nil {
+func writeContent(dt *DbfTable, f io.Writer) error {
+	unlock := dt.lockForReading()
+	_, dsErr := f.Write(dt.dataStore)
+	unlock()
+	if dsErr != nil {
 		return dsErr
 	}
-	return nil
+
+	if dt.storage == nil {
+		return nil
+	}
+
+	// tables opened with OpenFile keep only their header in dataStore
+	for row := 0; row < dt.NumberOfRecords(); row++ {
+		record, recordErr := dt.recordBytes(row)
+		if recordErr != nil {
+			return recordErr
+		}
+		if _, writeErr := f.Write(record); writeErr != nil {
+			return writeErr
+		}
+	}
+	_, eofErr := f.Write([]byte{dt.eofMarker})
+	return eofErr
 }
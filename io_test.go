@@ -240,14 +240,13 @@ func TestFieldsNameCorrectDetect(t *testing.T) {
 	g.Expect(tableUnderTest.FieldNames()).To(Equal(expectedFieldNames))
 }
 
-func TestNewFromFile_ReaderPanics_Errors(t *testing.T) {
+func TestNewFromFile_ReaderPanics_PanicNotRecovered(t *testing.T) {
 	g := NewGomegaWithT(t)
 
 	reader = panicReader
-	_, readError := NewFromFile(lessThanActualRecordsFile, testEncoding)
+	defer func() { reader = io.ReadFull }()
 
-	g.Expect(readError).ToNot(BeNil())
-	t.Log(readError)
+	g.Expect(func() { NewFromFile(lessThanActualRecordsFile, testEncoding) }).To(Panic())
 }
 
 func panicReader(r io.Reader, buf []byte) (int, error) {
@@ -258,6 +257,7 @@ func TestNewFromFile_ReaderErrors_Errors(t *testing.T) {
 	g := NewGomegaWithT(t)
 
 	reader = errorReader
+	defer func() { reader = io.ReadFull }()
 	_, readError := NewFromFile(lessThanActualRecordsFile, testEncoding)
 
 	g.Expect(readError).ToNot(BeNil())
@@ -273,6 +273,7 @@ func TestNewFromFile_OpenErrors_Errors(t *testing.T) {
 	g := NewGomegaWithT(t)
 
 	fsWrapper = openErrorFileSystem{}
+	defer func() { fsWrapper = osFileSystem{} }()
 	_, readError := NewFromFile(lessThanActualRecordsFile, testEncoding)
 
 	g.Expect(readError).ToNot(BeNil())
@@ -291,6 +292,7 @@ func TestNewFromFile_StatErrors_Errors(t *testing.T) {
 	g := NewGomegaWithT(t)
 
 	fsWrapper = statErrorFileSystem{}
+	defer func() { fsWrapper = osFileSystem{} }()
 	_, readError := NewFromFile(lessThanActualRecordsFile, testEncoding)
 
 	g.Expect(readError).ToNot(BeNil())
@@ -317,6 +319,7 @@ func TestSaveToFile_CreateErrors_Errors(t *testing.T) {
 	tempFilename := filepath.Join("testdata", "tempSavedTable.dbf")
 
 	fsWrapper = createErrorFileSystem{}
+	defer func() { fsWrapper = osFileSystem{} }()
 	saveErr := SaveToFile(tableFromBytes, tempFilename)
 
 	g.Expect(saveErr).ToNot(BeNil())
@@ -331,7 +334,7 @@ func (createErrorFileSystem) Create(name string) (*os.File, error) {
 	return nil, errors.New("Create error")
 }
 
-func TestSaveToFile_CreatePanics_Errors(t *testing.T) {
+func TestSaveToFile_CreatePanics_PanicNotRecovered(t *testing.T) {
 	g := NewGomegaWithT(t)
 
 	rawFileBytes, loadErr := os.ReadFile(validTestFile)
@@ -343,10 +346,9 @@ func TestSaveToFile_CreatePanics_Errors(t *testing.T) {
 	tempFilename := filepath.Join("testdata", "tempSavedTable.dbf")
 
 	fsWrapper = createPanicFileSystem{}
-	saveErr := SaveToFile(tableFromBytes, tempFilename)
+	defer func() { fsWrapper = osFileSystem{} }()
 
-	g.Expect(saveErr).ToNot(BeNil())
-	t.Log(saveErr)
+	g.Expect(func() { SaveToFile(tableFromBytes, tempFilename) }).To(Panic())
 }
 
 type createPanicFileSystem struct {
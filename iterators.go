@@ -0,0 +1,94 @@
+package godbf
+
+import (
+	"fmt"
+	"iter"
+)
+
+// RowNumbers returns an iterator over the row numbers of the table's rows, in order, skipping rows marked as deleted
+// unless SetDeleted(false) has been called. Iteration stops at the first row the table cannot read, after which Err
+// returns the error.
+//
+//	for row := range dt.RowNumbers() {
+//		...
+//	}
+//	if err := dt.Err(); err != nil {
+//		...
+//	}
+func (dt *DbfTable) RowNumbers() iter.Seq[int] {
+	return dt.rowNumbers(dt.showDeleted)
+}
+
+// Records returns an iterator over the row numbers and field values, as GetRowAsSlice returns them, of each row
+// RowNumbers iterates over.
+//
+//	for row, values := range dt.Records() {
+//		...
+//	}
+func (dt *DbfTable) Records() iter.Seq2[int, []string] {
+	return dt.records(dt.showDeleted)
+}
+
+// RecordsWithDeleted returns an iterator over the row numbers and field values of every row in the table, including
+// rows marked as deleted, whatever SetDeleted has been set to.
+func (dt *DbfTable) RecordsWithDeleted() iter.Seq2[int, []string] {
+	return dt.records(true)
+}
+
+// Rows returns an iterator decoding each row RowNumbers iterates over into a new value of the struct type T, as
+// DbfTable.Unmarshal does. A row that cannot be decoded yields the error, along with the partially decoded value. A row
+// the table cannot read yields the error Err returns, and ends the iteration.
+//
+//	for customer, err := range godbf.Rows[Customer](dt) {
+//		...
+//	}
+func Rows[T any](dt *DbfTable) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for row := range dt.RowNumbers() {
+			var value T
+			unmarshalErr := dt.Unmarshal(row, &value)
+			if !yield(value, unmarshalErr) {
+				return
+			}
+		}
+		if iterationErr := dt.Err(); iterationErr != nil {
+			var value T
+			yield(value, iterationErr)
+		}
+	}
+}
+
+// Err returns the error that stopped the last iteration over the table's rows, by RowNumbers, Records,
+// RecordsWithDeleted, Rows, ForEachRow or ForEachRecord, or nil if it stopped at the last row or was ended by its caller.
+func (dt *DbfTable) Err() error {
+	return dt.iterationErr
+}
+
+func (dt *DbfTable) rowNumbers(includeDeleted bool) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		dt.iterationErr = nil
+		for row := 0; row < dt.NumberOfRecords(); row++ {
+			record, recordErr := dt.recordBytes(row)
+			if recordErr != nil {
+				dt.iterationErr = fmt.Errorf("row %d could not be read: %w", row, recordErr)
+				return
+			}
+			if !includeDeleted && record[recordDeletionFlagIndex] == recordIsDeleted {
+				continue
+			}
+			if !yield(row) {
+				return
+			}
+		}
+	}
+}
+
+func (dt *DbfTable) records(includeDeleted bool) iter.Seq2[int, []string] {
+	return func(yield func(int, []string) bool) {
+		for row := range dt.rowNumbers(includeDeleted) {
+			if !yield(row, dt.GetRowAsSlice(row)) {
+				return
+			}
+		}
+	}
+}
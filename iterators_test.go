@@ -0,0 +1,151 @@
+package godbf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDbfTable_Records_DeletedRowsSkipped(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newDeletionTestTable(g)
+	tableUnderTest.SetRowIsDeleted(1)
+
+	var rows []int
+	var names []string
+	for row, values := range tableUnderTest.Records() {
+		rows = append(rows, row)
+		names = append(names, values[0])
+	}
+
+	g.Expect(rows).To(Equal([]int{0, 2}))
+	g.Expect(names).To(Equal([]string{"alice", "carol"}))
+}
+
+func TestDbfTable_RecordsWithDeleted_AllRowsIncluded(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newDeletionTestTable(g)
+	tableUnderTest.SetRowIsDeleted(1)
+
+	var names []string
+	for _, values := range tableUnderTest.RecordsWithDeleted() {
+		names = append(names, values[0])
+	}
+
+	g.Expect(names).To(Equal([]string{"alice", "bob", "carol"}))
+}
+
+func TestDbfTable_Records_BreakStopsEarly(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newDeletionTestTable(g)
+
+	var rows []int
+	for row := range tableUnderTest.Records() {
+		rows = append(rows, row)
+		if row == 1 {
+			break
+		}
+	}
+
+	g.Expect(rows).To(Equal([]int{0, 1}))
+}
+
+func TestDbfTable_RowNumbers_SetDeletedOff_DeletedRowsIncluded(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newDeletionTestTable(g)
+	tableUnderTest.SetRowIsDeleted(0)
+	tableUnderTest.SetDeleted(false)
+
+	var rows []int
+	for row := range tableUnderTest.RowNumbers() {
+		rows = append(rows, row)
+	}
+
+	g.Expect(rows).To(Equal([]int{0, 1, 2}))
+}
+
+func TestRows_DecodesStructs(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newDeletionTestTable(g)
+	tableUnderTest.SetRowIsDeleted(2)
+
+	type person struct {
+		Name string `dbf:"NAME"`
+	}
+
+	var people []person
+	for p, err := range Rows[person](tableUnderTest) {
+		g.Expect(err).To(BeNil())
+		people = append(people, p)
+	}
+
+	g.Expect(people).To(Equal([]person{{"alice"}, {"bob"}}))
+}
+
+func TestRows_UndecodableRow_YieldsError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newDeletionTestTable(g)
+
+	type numbered struct {
+		Name int `dbf:"NAME"`
+	}
+
+	for _, err := range Rows[numbered](tableUnderTest) {
+		g.Expect(err).ToNot(BeNil())
+		break
+	}
+}
+
+func TestDbfTable_Records_UnreadableRow_StopsWithErr(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tempFilename := filepath.Join(t.TempDir(), "truncatedTable.dbf")
+	copyValidTestFile(g, tempFilename)
+
+	tableUnderTest, openErr := OpenFile(tempFilename, testEncoding, WithPageCache(1, 1))
+	g.Expect(openErr).To(BeNil())
+	defer tableUnderTest.Close()
+
+	truncatedLength := int64(tableUnderTest.numberOfBytesInHeader) + int64(tableUnderTest.lengthOfEachRecord)
+	g.Expect(os.Truncate(tempFilename, truncatedLength)).To(Succeed())
+
+	var rows []int
+	for row := range tableUnderTest.Records() {
+		rows = append(rows, row)
+	}
+	g.Expect(rows).To(Equal([]int{0}))
+	g.Expect(tableUnderTest.Err()).To(Not(BeNil()))
+
+	recordErr := tableUnderTest.ForEachRecord(func(int, []string) error { return nil })
+	g.Expect(recordErr).To(Not(BeNil()))
+
+	type text struct {
+		Text string `dbf:"TESTTEXT"`
+	}
+
+	var rowErrs []error
+	for _, err := range Rows[text](tableUnderTest) {
+		rowErrs = append(rowErrs, err)
+	}
+	g.Expect(rowErrs).To(HaveLen(2))
+	g.Expect(rowErrs[0]).To(BeNil())
+	g.Expect(rowErrs[1]).To(Not(BeNil()))
+}
+
+func TestDbfTable_Records_AllRowsRead_ErrNil(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newDeletionTestTable(g)
+
+	for range tableUnderTest.Records() {
+	}
+	g.Expect(tableUnderTest.Err()).To(BeNil())
+}
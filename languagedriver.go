@@ -0,0 +1,143 @@
+package godbf
+
+import (
+	"strconv"
+	"strings"
+)
+
+// AutoEncoding can be passed as the file encoding when reading a table to have the encoding of its text derived from
+// the table itself: from the code page named in its .cpg file, if it has one and is read from a file, or otherwise
+// from the language driver ID in its header. Tables with no language driver ID, or one with no matching encoding, are
+// read as windows-1252. See AutoEncodingWithDefault for a different fallback.
+const AutoEncoding = "auto"
+
+// autoEncodingFallback is the encoding used by AutoEncoding when a table gives no encoding of its own.
+const autoEncodingFallback = "windows-1252"
+
+// languageDriverOffset is the offset of the language driver ID in the table header.
+const languageDriverOffset = 29
+
+// cpgFileExtension is the extension of the file naming the code page of a table, as written by GIS software.
+const cpgFileExtension = ".cpg"
+
+// AutoEncodingWithDefault returns a file encoding that derives the encoding of a table's text as AutoEncoding does,
+// but falls back to defaultEncoding when the table gives no encoding of its own.
+func AutoEncodingWithDefault(defaultEncoding string) string {
+	return AutoEncoding + ":" + defaultEncoding
+}
+
+// LanguageDriver is the language driver ID held in a table's header, identifying the code page of its text. A value
+// of 0 means the table does not identify one.
+type LanguageDriver byte
+
+// LanguageDriver returns the language driver ID held in the table's header.
+func (dt *DbfTable) LanguageDriver() LanguageDriver {
+	defer dt.lockForReading()()
+	if len(dt.dataStore) <= languageDriverOffset {
+		return 0
+	}
+	return LanguageDriver(dt.dataStore[languageDriverOffset])
+}
+
+// TextEncoding returns the name of the encoding the table's text is read and written with. For tables read with
+// AutoEncoding, it is the encoding derived from the table.
+func (dt *DbfTable) TextEncoding() string {
+	return dt.textEncoding
+}
+
+// parseAutoEncoding returns whether the file encoding asks for the encoding to be derived from the table, and if so,
+// the encoding to fall back to.
+func parseAutoEncoding(fileEncoding string) (fallback string, isAuto bool) {
+	if fileEncoding == AutoEncoding {
+		return autoEncodingFallback, true
+	}
+	fallback, isAuto = strings.CutPrefix(fileEncoding, AutoEncoding+":")
+	return fallback, isAuto
+}
+
+// resolveEncoding returns the encoding of the text of a table with the given language driver ID, read with the given
+// file encoding.
+func resolveEncoding(fileEncoding string, languageDriver LanguageDriver) string {
+	fallback, isAuto := parseAutoEncoding(fileEncoding)
+	if !isAuto {
+		return fileEncoding
+	}
+	if encoding := languageDriver.Encoding(); encoding != "" {
+		return encoding
+	}
+	return fallback
+}
+
+// useFileEncoding uses the encoding of the text of a table with the given header, read with the given file encoding.
+func (dt *DbfTable) useFileEncoding(fileEncoding string, header []byte) error {
+	var languageDriver LanguageDriver
+	if len(header) > languageDriverOffset {
+		languageDriver = LanguageDriver(header[languageDriverOffset])
+	}
+	return dt.UseEncoding(resolveEncoding(fileEncoding, languageDriver))
+}
+
+// withCodePageFile returns the encoding named in the .cpg file accompanying the table of the given file name, when
+// the file encoding asks for the encoding to be derived from the table, and the table has a .cpg file naming an
+// encoding that is supported. Otherwise, the file encoding is returned as is.
+func withCodePageFile(fileName string, fileEncoding string) string {
+	if _, isAuto := parseAutoEncoding(fileEncoding); !isAuto {
+		return fileEncoding
+	}
+
+	cpgFileName, findErr := findCompanionFile(fileName, cpgFileExtension)
+	if findErr != nil {
+		return fileEncoding
+	}
+	content, readErr := readFile(cpgFileName)
+	if readErr != nil {
+		return fileEncoding
+	}
+
+	if encoding := codePageFileEncoding(string(content)); encoding != "" {
+		return encoding
+	}
+	return fileEncoding
+}
+
+// codePageFileEncoding returns the name of the encoding named by the content of a .cpg file, or "" if it names no
+// supported encoding. The content is a code page number, optionally prefixed as in "ANSI 1251" or "CP1252", an ISO
+// 8859 part as in "88591" or "8859-5", or an encoding name.
+func codePageFileEncoding(content string) string {
+	name := strings.TrimSpace(strings.TrimPrefix(content, "\uFEFF"))
+	upperName := strings.ToUpper(name)
+
+	switch upperName {
+	case "UTF-8", "UTF8":
+		return "UTF-8"
+	}
+
+	if part, isISO := strings.CutPrefix(upperName, "8859"); isISO {
+		part = strings.TrimPrefix(part, "-")
+		if _, numberErr := strconv.Atoi(part); numberErr == nil {
+			return supportedEncoding("ISO-8859-" + part)
+		}
+	}
+
+	number := upperName
+	for _, prefix := range []string{"ANSI", "OEM", "CP", "WINDOWS-"} {
+		number = strings.TrimSpace(strings.TrimPrefix(number, prefix))
+	}
+	if codePage, numberErr := strconv.Atoi(number); numberErr == nil {
+		encoding, _ := EncodingForCodePage(codePage)
+		return encoding
+	}
+
+	if encoding, found := lookup[name]; found {
+		return encoding
+	}
+	return supportedEncoding(name)
+}
+
+// supportedEncoding returns the given encoding name if it names a supported encoding, or "" if not.
+func supportedEncoding(name string) string {
+	if _, encodingErr := encodingNamed(name); encodingErr != nil {
+		return ""
+	}
+	return name
+}
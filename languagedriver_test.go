@@ -0,0 +1,134 @@
+package godbf
+
+import (
+	"bytes"
+	. "github.com/onsi/gomega"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newCyrillicTestTable(g *GomegaWithT, encoding string) []byte {
+	table := New(encoding)
+	table.AddTextField("NAME", 12)
+	row, _ := table.AddNewRecord()
+	g.Expect(table.SetFieldValueByName(row, "NAME", "Привет")).To(Succeed())
+
+	var content bytes.Buffer
+	g.Expect(writeContent(table, &content)).To(Succeed())
+	return content.Bytes()
+}
+
+func TestLanguageDriver_Encoding_DocumentedIDs(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(LanguageDriver(0x01).CodePage()).To(Equal(437))
+	g.Expect(LanguageDriver(0x01).Encoding()).To(Equal("IBM437"))
+	g.Expect(LanguageDriver(0x65).Encoding()).To(Equal("IBM866"))
+	g.Expect(LanguageDriver(0xC9).Encoding()).To(Equal("windows-1251"))
+	g.Expect(LanguageDriver(0x4D).Encoding()).To(Equal("GBK"))
+	g.Expect(LanguageDriver(0x57).Encoding()).To(Equal("windows-1252"))
+
+	g.Expect(LanguageDriver(0x00).CodePage()).To(Equal(0))
+	g.Expect(LanguageDriver(0x00).Encoding()).To(Equal(""))
+	g.Expect(LanguageDriver(0x68).Encoding()).To(Equal(""), "Kamenicky has no supported encoding")
+}
+
+func TestNewFromByteArray_AutoEncoding_DerivedFromLanguageDriver(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	content := newCyrillicTestTable(g, "windows-1251")
+
+	tableUnderTest, loadErr := NewFromByteArray(content, AutoEncoding)
+	g.Expect(loadErr).To(BeNil())
+
+	g.Expect(tableUnderTest.LanguageDriver()).To(Equal(LanguageDriver(0xC9)))
+	g.Expect(tableUnderTest.TextEncoding()).To(Equal("windows-1251"))
+	g.Expect(tableUnderTest.FieldValueByName(0, "NAME")).To(Equal("Привет"))
+}
+
+func TestNewFromByteArray_AutoEncoding_NoLanguageDriverUsesDefault(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	content := newCyrillicTestTable(g, "IBM866")
+	content[languageDriverOffset] = 0
+
+	tableUnderTest, loadErr := NewFromByteArray(content, AutoEncodingWithDefault("IBM866"))
+	g.Expect(loadErr).To(BeNil())
+
+	g.Expect(tableUnderTest.LanguageDriver()).To(Equal(LanguageDriver(0)))
+	g.Expect(tableUnderTest.TextEncoding()).To(Equal("IBM866"))
+	g.Expect(tableUnderTest.FieldValueByName(0, "NAME")).To(Equal("Привет"))
+
+	tableUnderTest, loadErr = NewFromByteArray(content, AutoEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(tableUnderTest.TextEncoding()).To(Equal(autoEncodingFallback))
+}
+
+func TestNewFromByteArray_AutoEncodingWithDefault_NewUtf8TableReadAsUtf8(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	content := newCyrillicTestTable(g, "UTF-8")
+	g.Expect(LanguageDriver(content[languageDriverOffset])).To(Equal(LanguageDriver(0)))
+
+	tableUnderTest, loadErr := NewFromByteArray(content, AutoEncodingWithDefault("UTF-8"))
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(tableUnderTest.TextEncoding()).To(Equal("UTF-8"))
+	g.Expect(tableUnderTest.FieldValueByName(0, "NAME")).To(Equal("Привет"))
+}
+
+func TestNewFromByteArray_ExplicitEncoding_LanguageDriverIgnored(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	content := newCyrillicTestTable(g, "windows-1251")
+
+	tableUnderTest, loadErr := NewFromByteArray(content, "IBM866")
+	g.Expect(loadErr).To(BeNil())
+
+	g.Expect(tableUnderTest.LanguageDriver()).To(Equal(LanguageDriver(0xC9)))
+	g.Expect(tableUnderTest.TextEncoding()).To(Equal("IBM866"))
+	g.Expect(tableUnderTest.FieldValueByName(0, "NAME")).NotTo(Equal("Привет"))
+}
+
+func TestNewFromFile_AutoEncoding_CodePageFileTakesPrecedence(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	content := newCyrillicTestTable(g, "windows-1251")
+	content[languageDriverOffset] = 0x57
+
+	tableFileName := filepath.Join(t.TempDir(), "cyrillic.dbf")
+	g.Expect(os.WriteFile(tableFileName, content, 0644)).To(Succeed())
+
+	tableUnderTest, loadErr := NewFromFile(tableFileName, AutoEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(tableUnderTest.TextEncoding()).To(Equal("windows-1252"))
+
+	cpgFileName := companionFileName(tableFileName, cpgFileExtension)
+	g.Expect(os.WriteFile(cpgFileName, []byte("ANSI 1251\r\n"), 0644)).To(Succeed())
+
+	tableUnderTest, loadErr = NewFromFile(tableFileName, AutoEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(tableUnderTest.TextEncoding()).To(Equal("windows-1251"))
+	g.Expect(tableUnderTest.FieldValueByName(0, "NAME")).To(Equal("Привет"))
+
+	openedTable, openErr := OpenFile(tableFileName, AutoEncoding, WithReadOnly())
+	g.Expect(openErr).To(BeNil())
+	g.Expect(openedTable.TextEncoding()).To(Equal("windows-1251"))
+	g.Expect(openedTable.Close()).To(Succeed())
+}
+
+func TestCodePageFileEncoding_Formats(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(codePageFileEncoding("UTF-8")).To(Equal("UTF-8"))
+	g.Expect(codePageFileEncoding("utf8\n")).To(Equal("UTF-8"))
+	g.Expect(codePageFileEncoding("1252")).To(Equal("windows-1252"))
+	g.Expect(codePageFileEncoding("ANSI 1251")).To(Equal("windows-1251"))
+	g.Expect(codePageFileEncoding("OEM 866")).To(Equal("IBM866"))
+	g.Expect(codePageFileEncoding("CP850")).To(Equal("IBM850"))
+	g.Expect(codePageFileEncoding("88591")).To(Equal("ISO-8859-1"))
+	g.Expect(codePageFileEncoding("8859-5")).To(Equal("ISO-8859-5"))
+	g.Expect(codePageFileEncoding("Big5")).To(Equal("Big5"))
+	g.Expect(codePageFileEncoding("not a code page")).To(Equal(""))
+	g.Expect(codePageFileEncoding("")).To(Equal(""))
+}
@@ -0,0 +1,355 @@
+package godbf
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Struct fields are mapped to table fields by a `dbf:"FIELDNAME"` tag, or, if untagged, by the struct field's name.
+// Names are matched exactly where possible, and otherwise case-insensitively. Any options following the name in the
+// tag are ignored. A tag of "-" skips the struct field, as do unexported struct fields and struct fields with no
+// matching table field.
+
+// dbfTagName is the struct tag key naming the table field a struct field maps to.
+const dbfTagName = "dbf"
+
+// FieldMarshaler is implemented by types that can render themselves as the text content of a table field.
+type FieldMarshaler interface {
+	MarshalDbfField() (string, error)
+}
+
+// FieldUnmarshaler is implemented by types that can set themselves from the text content of a table field.
+type FieldUnmarshaler interface {
+	UnmarshalDbfField(value string) error
+}
+
+var (
+	timeType             = reflect.TypeOf(time.Time{})
+	fieldMarshalerType   = reflect.TypeOf((*FieldMarshaler)(nil)).Elem()
+	fieldUnmarshalerType = reflect.TypeOf((*FieldUnmarshaler)(nil)).Elem()
+)
+
+// structFieldMapping pairs a struct field, by its reflect index path, with the table field it maps to.
+type structFieldMapping struct {
+	structIndex []int
+	fieldIndex  int
+}
+
+// Unmarshal reads the record at the given row into the struct v points to.
+// Struct fields may be strings, signed or unsigned integers, floats, bools, time.Time, pointers to any of these (left
+// nil for empty or null table fields), or types implementing FieldUnmarshaler.
+// If v is not a non-nil pointer to a struct, or a value cannot be converted to its struct field's type, an error is
+// returned.
+func (dt *DbfTable) Unmarshal(row int, v any) error {
+	structValue, valueErr := structValueOf(v)
+	if valueErr != nil {
+		return valueErr
+	}
+
+	if row < 0 || !dt.HasRecord(row) {
+		return fmt.Errorf("row %d does not exist: %w", row, ErrRowOutOfBounds)
+	}
+
+	for _, mapping := range dt.structFieldMappings(structValue.Type()) {
+		if unmarshalErr := dt.unmarshalField(row, mapping.fieldIndex, structValue.FieldByIndex(mapping.structIndex)); unmarshalErr != nil {
+			return fmt.Errorf("field \"%s\": %w", dt.fields[mapping.fieldIndex].name, unmarshalErr)
+		}
+	}
+	return nil
+}
+
+// Marshal writes the struct v (or the struct v points to) into the record at the given row.
+// Struct fields may be of the types Unmarshal supports, or types implementing FieldMarshaler. A nil pointer sets a
+// nullable Visual FoxPro field to null, and clears any other field.
+// If v is not a struct, or a value cannot be stored in its table field, an error is returned.
+func (dt *DbfTable) Marshal(row int, v any) error {
+	structValue := reflect.ValueOf(v)
+	for structValue.Kind() == reflect.Pointer && !structValue.IsNil() {
+		structValue = structValue.Elem()
+	}
+	if structValue.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot marshal %T; expected a struct or pointer to a struct", v)
+	}
+
+	if row < 0 || !dt.HasRecord(row) {
+		return fmt.Errorf("row %d does not exist: %w", row, ErrRowOutOfBounds)
+	}
+
+	for _, mapping := range dt.structFieldMappings(structValue.Type()) {
+		if marshalErr := dt.marshalField(row, mapping.fieldIndex, structValue.FieldByIndex(mapping.structIndex)); marshalErr != nil {
+			return fmt.Errorf("field \"%s\": %w", dt.fields[mapping.fieldIndex].name, marshalErr)
+		}
+	}
+	return nil
+}
+
+// MarshalNewRecord adds a new record to the table, and writes the struct v (or the struct v points to) into it as
+// Marshal does, returning the index number of the record.
+func (dt *DbfTable) MarshalNewRecord(v any) (newRecordNumber int, err error) {
+	newRecordNumber, addErr := dt.AddNewRecord()
+	if addErr != nil {
+		return -1, addErr
+	}
+	return newRecordNumber, dt.Marshal(newRecordNumber, v)
+}
+
+func structValueOf(v any) (reflect.Value, error) {
+	pointerValue := reflect.ValueOf(v)
+	if pointerValue.Kind() != reflect.Pointer || pointerValue.IsNil() || pointerValue.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("cannot unmarshal into %T; expected a non-nil pointer to a struct", v)
+	}
+	return pointerValue.Elem(), nil
+}
+
+// parseDbfTag splits a `dbf` struct tag into the table field name it names, and any comma-separated options
+// following the name.
+func parseDbfTag(tag string) (name string, options []string) {
+	parts := strings.Split(tag, ",")
+	return strings.TrimSpace(parts[0]), parts[1:]
+}
+
+// structFieldMappings returns the mapping of the exported fields of the given struct type to the table's fields.
+func (dt *DbfTable) structFieldMappings(structType reflect.Type) []structFieldMapping {
+	mappings := make([]structFieldMapping, 0, structType.NumField())
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		name := structField.Name
+		if tag, tagged := structField.Tag.Lookup(dbfTagName); tagged {
+			if tag == "-" {
+				continue
+			}
+			if tagName, _ := parseDbfTag(tag); tagName != "" {
+				name = tagName
+			}
+		}
+
+		if fieldIndex, found := dt.fieldIndexMatching(name); found {
+			mappings = append(mappings, structFieldMapping{structIndex: structField.Index, fieldIndex: fieldIndex})
+		}
+	}
+	return mappings
+}
+
+// fieldIndexMatching returns the index of the field with the given name, matching exactly where possible, and
+// otherwise case-insensitively. Names too long for a field name match the field named by their truncation, as
+// addField would name it.
+func (dt *DbfTable) fieldIndexMatching(name string) (int, bool) {
+	if fieldIndex, found := dt.fieldMap[name]; found {
+		return fieldIndex, true
+	}
+	normalisedName, _ := dt.normaliseFieldName(name)
+	for i := range dt.fields {
+		if strings.EqualFold(dt.fields[i].name, name) || strings.EqualFold(dt.fields[i].name, normalisedName) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// isEmpty returns true if the field at the given row and field index holds null, no value, or an uninitialised
+// logical value.
+func (dt *DbfTable) isEmpty(row int, fieldIndex int) bool {
+	if dt.IsNull(row, fieldIndex) {
+		return true
+	}
+	value := dt.FieldValue(row, fieldIndex)
+	return value == "" || (dt.fields[fieldIndex].fieldType == Logical && value == logicalUninitialised)
+}
+
+func (dt *DbfTable) unmarshalField(row int, fieldIndex int, target reflect.Value) error {
+	if target.Kind() == reflect.Pointer && !target.Type().Implements(fieldUnmarshalerType) {
+		if dt.isEmpty(row, fieldIndex) {
+			target.SetZero()
+			return nil
+		}
+		element := reflect.New(target.Type().Elem())
+		if unmarshalErr := dt.unmarshalField(row, fieldIndex, element.Elem()); unmarshalErr != nil {
+			return unmarshalErr
+		}
+		target.Set(element)
+		return nil
+	}
+
+	if target.CanAddr() && target.Addr().Type().Implements(fieldUnmarshalerType) {
+		value, decodeErr := dt.fieldValue(row, fieldIndex)
+		if decodeErr != nil {
+			return decodeErr
+		}
+		return target.Addr().Interface().(FieldUnmarshaler).UnmarshalDbfField(value)
+	}
+
+	if target.Type() == timeType {
+		value, timeErr := dt.timeFieldValue(row, fieldIndex)
+		if timeErr != nil {
+			return timeErr
+		}
+		target.Set(reflect.ValueOf(value))
+		return nil
+	}
+
+	field := dt.fields[fieldIndex]
+	value, decodeErr := dt.fieldValue(row, fieldIndex)
+	if decodeErr != nil {
+		return decodeErr
+	}
+
+	switch target.Kind() {
+	case reflect.String:
+		target.SetString(value)
+	case reflect.Bool:
+		boolValue, _, boolErr := parseLogical(value)
+		if boolErr != nil {
+			return boolErr
+		}
+		target.SetBool(boolValue)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if value == "" {
+			target.SetInt(0)
+			return nil
+		}
+		intValue, parseErr := parseWholeNumber(value)
+		if parseErr != nil {
+			return parseErr
+		}
+		if target.OverflowInt(intValue) {
+			return fmt.Errorf("value %s overflows %s", value, target.Type())
+		}
+		target.SetInt(intValue)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if value == "" {
+			target.SetUint(0)
+			return nil
+		}
+		intValue, parseErr := parseWholeNumber(value)
+		if parseErr != nil {
+			return parseErr
+		}
+		if intValue < 0 || target.OverflowUint(uint64(intValue)) {
+			return fmt.Errorf("value %s overflows %s", value, target.Type())
+		}
+		target.SetUint(uint64(intValue))
+	case reflect.Float32, reflect.Float64:
+		if value == "" {
+			target.SetFloat(0)
+			return nil
+		}
+		floatValue, parseErr := strconv.ParseFloat(value, target.Type().Bits())
+		if parseErr != nil {
+			return fmt.Errorf("value %q of %s field is not a number: %w", value, field.fieldType.name(), parseErr)
+		}
+		target.SetFloat(floatValue)
+	default:
+		return fmt.Errorf("struct field type %s is not supported", target.Type())
+	}
+	return nil
+}
+
+func (dt *DbfTable) marshalField(row int, fieldIndex int, source reflect.Value) error {
+	field := dt.fields[fieldIndex]
+
+	if source.Type().Implements(fieldMarshalerType) && !(source.Kind() == reflect.Pointer && source.IsNil()) {
+		value, marshalErr := source.Interface().(FieldMarshaler).MarshalDbfField()
+		if marshalErr != nil {
+			return marshalErr
+		}
+		return dt.SetFieldValue(row, fieldIndex, value)
+	}
+
+	if source.Kind() == reflect.Pointer {
+		if source.IsNil() {
+			if field.IsNullable() {
+				return dt.SetNullByName(row, field.name)
+			}
+			return dt.SetFieldValue(row, fieldIndex, "")
+		}
+		return dt.marshalField(row, fieldIndex, source.Elem())
+	}
+
+	if source.Type() == timeType {
+		return dt.setTimeFieldValue(row, fieldIndex, source.Interface().(time.Time))
+	}
+
+	switch source.Kind() {
+	case reflect.String:
+		return dt.SetFieldValue(row, fieldIndex, source.String())
+	case reflect.Bool:
+		if source.Bool() {
+			return dt.SetFieldValue(row, fieldIndex, logicalTrue)
+		}
+		return dt.SetFieldValue(row, fieldIndex, logicalFalse)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return dt.SetFieldValue(row, fieldIndex, strconv.FormatInt(source.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return dt.SetFieldValue(row, fieldIndex, strconv.FormatUint(source.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		precision := -1
+		if field.fieldType == Numeric || field.fieldType == Float {
+			precision = int(field.decimalPlaces)
+		}
+		return dt.SetFieldValue(row, fieldIndex, strconv.FormatFloat(source.Float(), 'f', precision, source.Type().Bits()))
+	default:
+		return fmt.Errorf("struct field type %s is not supported", source.Type())
+	}
+}
+
+// timeFieldValue returns the value of the field at the given row and field index as a time.Time, interpreting text
+// content as a date or date and time.
+func (dt *DbfTable) timeFieldValue(row int, fieldIndex int) (time.Time, error) {
+	field := dt.fields[fieldIndex]
+	switch field.fieldType {
+	case Date:
+		return dt.TimeFieldValueByName(row, field.name)
+	case DateTime, Timestamp:
+		return dt.DateTimeFieldValueByName(row, field.name)
+	}
+
+	value := dt.FieldValue(row, fieldIndex)
+	switch len(value) {
+	case 0:
+		return time.Time{}, nil
+	case len(DateFormat):
+		return time.Parse(DateFormat, value)
+	default:
+		return time.Parse(DateTimeFormat, value)
+	}
+}
+
+// setTimeFieldValue sets the field at the given row and field index to value, storing it as text if the field is
+// not a date field.
+func (dt *DbfTable) setTimeFieldValue(row int, fieldIndex int, value time.Time) error {
+	field := dt.fields[fieldIndex]
+	switch field.fieldType {
+	case Date:
+		return dt.SetTimeFieldValueByName(row, field.name, value)
+	case DateTime, Timestamp:
+		return dt.SetDateTimeFieldValueByName(row, field.name, value)
+	}
+
+	if value.IsZero() {
+		return dt.SetFieldValue(row, fieldIndex, "")
+	}
+	return dt.SetFieldValue(row, fieldIndex, value.Format(DateTimeFormat))
+}
+
+// parseWholeNumber parses value as an integer, accepting numbers written with a fractional part of zero, as Numeric
+// fields with decimal places store them.
+func parseWholeNumber(value string) (int64, error) {
+	if intValue, parseErr := strconv.ParseInt(value, 10, 64); parseErr == nil {
+		return intValue, nil
+	}
+
+	floatValue, parseErr := strconv.ParseFloat(value, 64)
+	if parseErr != nil || floatValue != math.Trunc(floatValue) || floatValue >= math.MaxInt64 || floatValue < math.MinInt64 {
+		return 0, errors.New("value \"" + value + "\" is not a whole number")
+	}
+	return int64(floatValue), nil
+}
@@ -0,0 +1,174 @@
+package godbf
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+type upperCaseName string
+
+func (n upperCaseName) MarshalDbfField() (string, error) {
+	if n == "" {
+		return "", errors.New("name must not be empty")
+	}
+	return strings.ToUpper(string(n)), nil
+}
+
+func (n *upperCaseName) UnmarshalDbfField(value string) error {
+	*n = upperCaseName(strings.ToLower(value))
+	return nil
+}
+
+type marshalTestRecord struct {
+	Name     upperCaseName `dbf:"NAME"`
+	Count    int           `dbf:"COUNT"`
+	Size     uint16        `dbf:"SIZE"`
+	Amount   float64       `dbf:"AMOUNT"`
+	Active   bool          `dbf:"ACTIVE"`
+	Born     time.Time     `dbf:"BORN"`
+	Note     *string       `dbf:"NOTE"`
+	Untagged string
+	Ignored  string `dbf:"-"`
+	internal string
+}
+
+func newMarshalTestTable() *DbfTable {
+	table := New(testEncoding)
+	table.AddTextField("NAME", 10)
+	table.AddNumberField("COUNT", 6, 0)
+	table.AddNumberField("SIZE", 5, 0)
+	table.AddNumberField("AMOUNT", 10, 2)
+	table.AddBooleanField("ACTIVE")
+	table.AddDateField("BORN")
+	table.AddTextField("NOTE", 10)
+	table.AddTextField("UNTAGGED", 10)
+	table.AddTextField("IGNORED", 10)
+	return table
+}
+
+func TestDbfTable_MarshalNewRecord(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newMarshalTestTable()
+
+	note := "a note"
+	recordIndex, marshalErr := tableUnderTest.MarshalNewRecord(marshalTestRecord{
+		Name:     "alice",
+		Count:    -42,
+		Size:     7,
+		Amount:   12.5,
+		Active:   true,
+		Born:     time.Date(1990, time.June, 15, 0, 0, 0, 0, time.UTC),
+		Note:     &note,
+		Untagged: "by name",
+		Ignored:  "skipped",
+	})
+	g.Expect(marshalErr).To(BeNil())
+
+	g.Expect(tableUnderTest.GetRowAsSlice(recordIndex)).To(Equal(
+		[]string{"ALICE", "-42", "7", "12.50", "T", "19900615", "a note", "by name", ""}))
+}
+
+func TestDbfTable_Unmarshal(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newMarshalTestTable()
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+	tableUnderTest.SetFieldValueByName(recordIndex, "NAME", "BOB")
+	tableUnderTest.SetFieldValueByName(recordIndex, "COUNT", "640")
+	tableUnderTest.SetFieldValueByName(recordIndex, "SIZE", "3")
+	tableUnderTest.SetFieldValueByName(recordIndex, "AMOUNT", "1.25")
+	tableUnderTest.SetFieldValueByName(recordIndex, "ACTIVE", "n")
+	tableUnderTest.SetFieldValueByName(recordIndex, "BORN", "20181201")
+	tableUnderTest.SetFieldValueByName(recordIndex, "UNTAGGED", "by name")
+	tableUnderTest.SetFieldValueByName(recordIndex, "IGNORED", "skipped")
+
+	var actualRecord marshalTestRecord
+	g.Expect(tableUnderTest.Unmarshal(recordIndex, &actualRecord)).To(Succeed())
+
+	g.Expect(actualRecord).To(Equal(marshalTestRecord{
+		Name:     "bob",
+		Count:    640,
+		Size:     3,
+		Amount:   1.25,
+		Active:   false,
+		Born:     time.Date(2018, time.December, 1, 0, 0, 0, 0, time.UTC),
+		Note:     nil,
+		Untagged: "by name",
+	}))
+}
+
+func TestDbfTable_Unmarshal_WholeNumberFromDecimalField(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	tableUnderTest.AddNumberField("COUNT", 6, 2)
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+	tableUnderTest.SetFieldValueByName(recordIndex, "COUNT", "640.00")
+
+	var actualRecord struct{ Count int8 }
+	overflowErr := tableUnderTest.Unmarshal(recordIndex, &actualRecord)
+	g.Expect(overflowErr).ToNot(BeNil())
+	t.Log(overflowErr)
+
+	var wideRecord struct{ Count int }
+	g.Expect(tableUnderTest.Unmarshal(recordIndex, &wideRecord)).To(Succeed())
+	g.Expect(wideRecord.Count).To(Equal(640))
+}
+
+func TestDbfTable_Unmarshal_Invalid_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newMarshalTestTable()
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+	tableUnderTest.SetFieldValueByName(recordIndex, "UNTAGGED", "abc")
+
+	var actualRecord marshalTestRecord
+	g.Expect(tableUnderTest.Unmarshal(recordIndex, actualRecord)).ToNot(Succeed())
+	g.Expect(tableUnderTest.Unmarshal(recordIndex+1, &actualRecord)).ToNot(Succeed())
+
+	var wrongType struct {
+		Untagged int
+	}
+	typeErr := tableUnderTest.Unmarshal(recordIndex, &wrongType)
+	g.Expect(typeErr).ToNot(BeNil())
+	t.Log(typeErr)
+
+	var unsupported struct {
+		Untagged []string
+	}
+	g.Expect(tableUnderTest.Unmarshal(recordIndex, &unsupported)).ToNot(Succeed())
+}
+
+func TestDbfTable_Marshal_FieldMarshalerError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newMarshalTestTable()
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+
+	marshalErr := tableUnderTest.Marshal(recordIndex, &marshalTestRecord{})
+	g.Expect(marshalErr).ToNot(BeNil())
+	t.Log(marshalErr)
+}
+
+func TestDbfTable_Marshal_NilPointerSetsNull(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromByteArray(visualFoxProTestBytes(), testEncoding)
+
+	var record struct {
+		Name   *string `dbf:"NAME"`
+		Amount *int32  `dbf:"AMOUNT"`
+	}
+	g.Expect(tableUnderTest.Unmarshal(0, &record)).To(Succeed())
+	g.Expect(*record.Name).To(Equal("alice"))
+	g.Expect(*record.Amount).To(BeNumerically("==", 42))
+
+	record.Name = nil
+	g.Expect(tableUnderTest.Marshal(0, record)).To(Succeed())
+	g.Expect(tableUnderTest.IsNullByName(0, "NAME")).To(BeTrue())
+}
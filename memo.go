@@ -0,0 +1,297 @@
+package godbf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MemoBlockType identifies the kind of content held in a memo file block, as per the FoxPro .FPT memo file format.
+// Memo file formats that do not record the type of their content treat all memos as TextMemoBlock.
+type MemoBlockType uint32
+
+const (
+	PictureMemoBlock MemoBlockType = 0 // Binary content, typically from a Picture field
+	TextMemoBlock    MemoBlockType = 1 // Text content, typically from a Memo field
+	ObjectMemoBlock  MemoBlockType = 2 // Binary content, typically an OLE object from a General field
+)
+
+// memoStore is the companion file that holds the content of a table's memo fields. A table's records hold only the
+// number of the block in the companion file where each memo's content starts.
+type memoStore interface {
+	readMemo(block uint32) ([]byte, MemoBlockType, error)
+	writeMemo(content []byte, blockType MemoBlockType) (uint32, error)
+	bytes() []byte
+	fileExtension() string
+	empty() memoStore // returns a memo file of the same format, and block size, holding no memos
+}
+
+// binaryMemoReferenceLength is the length of memo fields holding their block number as a little-endian integer,
+// rather than as ASCII digits.
+const binaryMemoReferenceLength = 4
+
+// newMemoStoreFromBytes interprets the raw bytes of a memo file, choosing the memo file format implied by the
+// file signature of the table that owns it.
+func newMemoStoreFromBytes(fileSignature byte, data []byte) (memoStore, error) {
+	switch fileSignature {
+	case dbaseIIIWithMemoFileSignature:
+		return newDbtMemoFromBytes(dbaseIIIMemo, data)
+	case dbaseIVWithMemoFileSignature, dbaseVIIWithMemoFileSignature:
+		return newDbtMemoFromBytes(dbaseIVMemo, data)
+	case foxProWithMemoFileSignature, visualFoxProFileSignature, visualFoxProAutoIncrementFileSignature, visualFoxProVarcharFileSignature:
+		return newFptMemoFromBytes(data)
+	default:
+		return nil, fmt.Errorf("file signature 0x%02X does not describe a table with a memo file", fileSignature)
+	}
+}
+
+// memoFileExtension returns the file extension of the memo file used by tables with the given file signature.
+func memoFileExtension(fileSignature byte) string {
+	switch fileSignature {
+	case foxProWithMemoFileSignature, visualFoxProFileSignature, visualFoxProAutoIncrementFileSignature, visualFoxProVarcharFileSignature:
+		return fptFileExtension
+	default:
+		return dbtFileExtension
+	}
+}
+
+// UseFoxProMemo gives the table an empty FoxPro .FPT memo file using blocks of the specified size, in place of the
+// dBase III .DBT memo file created by default when memo fields are added.
+// An error is returned if the table schema is locked, or memo fields have already been added.
+func (dt *DbfTable) UseFoxProMemo(blockSize uint16) error {
+	if dt.schemaLocked {
+		return errors.New("changing the memo file is not allowed once you start storing table data to or open an existing dbase file")
+	}
+	if dt.hasMemoFields() {
+		return errors.New("changing the memo file is not allowed once memo fields have been added")
+	}
+	if blockSize == 0 {
+		return errors.New("memo file block size must be greater than 0")
+	}
+
+	dt.memo = newFptMemo(blockSize)
+	dt.fileSignature = foxProWithMemoFileSignature
+	dt.dataStore[0] = dt.fileSignature
+
+	return nil
+}
+
+// hasMemoFields returns true if any of the table's fields stores its content in the memo file.
+func (dt *DbfTable) hasMemoFields() bool {
+	for _, field := range dt.fields {
+		if dt.storesInMemo(field) {
+			return true
+		}
+	}
+	return false
+}
+
+// attachMemo sets the table's memo file to the one encoded in the supplied bytes.
+func (dt *DbfTable) attachMemo(memoData []byte) error {
+	memo, memoErr := newMemoStoreFromBytes(dt.fileSignature, memoData)
+	if memoErr != nil {
+		return memoErr
+	}
+	dt.memo = memo
+	return nil
+}
+
+// MemoFieldValueByName returns the memo content of a field given row number and name provided.
+// Text content is returned as a string, and binary (picture or object) content as a []byte.
+// If the field does not exist, or does not store its content in the memo file, an error is returned.
+func (dt *DbfTable) MemoFieldValueByName(row int, fieldName string) (value any, err error) {
+	if fieldIndex, entryFound := dt.fieldMap[fieldName]; entryFound {
+		return dt.MemoFieldValue(row, fieldIndex)
+	}
+	return nil, &FieldNotFoundError{FieldName: fieldName}
+}
+
+// MemoFieldValue returns the memo content for the record at the given row and field index.
+// Text content is returned as a string, and binary (picture or object) content as a []byte. A field referencing no
+// memo returns an empty string.
+// If the row or field does not exist, the field does not store its content in the memo file, or the memo cannot be
+// read, an error is returned.
+func (dt *DbfTable) MemoFieldValue(row int, fieldIndex int) (value any, err error) {
+	if row < 0 || !dt.HasRecord(row) {
+		return nil, fmt.Errorf("row %d does not exist: %w", row, ErrRowOutOfBounds)
+	}
+	if fieldIndex < 0 || fieldIndex >= len(dt.fields) {
+		return nil, fmt.Errorf("field index %d does not exist: %w", fieldIndex, ErrFieldNotFound)
+	}
+	if !dt.storesInMemo(dt.fields[fieldIndex]) {
+		return nil, errors.New("type of field \"" + dt.fields[fieldIndex].name + "\" is not Memo, General, Picture or Binary")
+	}
+
+	fieldBytes, bytesErr := dt.fieldBytes(row, fieldIndex)
+	if bytesErr != nil {
+		return nil, bytesErr
+	}
+	content, blockType, readErr := dt.readMemo(fieldBytes)
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	if blockType != TextMemoBlock || dt.fields[fieldIndex].fieldType == Binary {
+		return content, nil
+	}
+	text, decodeErr := dt.decode(content)
+	if decodeErr != nil {
+		return nil, dt.encodingError(row, fieldIndex, decodeErr)
+	}
+	return text, nil
+}
+
+// SetMemoFieldValueByName sets the memo content for the given row and field name as specified.
+// A string value is stored as text, and a []byte value as binary content.
+// If the field does not exist, or the value cannot be stored in the field, an error is returned.
+func (dt *DbfTable) SetMemoFieldValueByName(row int, fieldName string, value any) (err error) {
+	if fieldIndex, found := dt.fieldMap[fieldName]; found {
+		return dt.SetMemoFieldValue(row, fieldIndex, value)
+	}
+	return &FieldNotFoundError{FieldName: fieldName}
+}
+
+// SetMemoFieldValue sets the memo content for the given row and field index as specified.
+// A string value is stored as text, and a []byte value as binary content. An empty value clears the field's
+// reference to any memo.
+// If the row or field does not exist, the field does not store its content in the memo file, or the value cannot be
+// stored, an error is returned.
+func (dt *DbfTable) SetMemoFieldValue(row int, fieldIndex int, value any) (err error) {
+	if writableErr := dt.verifyWritable(); writableErr != nil {
+		return writableErr
+	}
+	if row < 0 || !dt.HasRecord(row) {
+		return fmt.Errorf("row %d does not exist: %w", row, ErrRowOutOfBounds)
+	}
+	if fieldIndex < 0 || fieldIndex >= len(dt.fields) {
+		return fmt.Errorf("field index %d does not exist: %w", fieldIndex, ErrFieldNotFound)
+	}
+
+	fieldType := dt.fields[fieldIndex].fieldType
+	if !dt.storesInMemo(dt.fields[fieldIndex]) {
+		return errors.New("type of field \"" + dt.fields[fieldIndex].name + "\" is not Memo, General, Picture or Binary")
+	}
+
+	fieldBytes, bytesErr := dt.fieldBytes(row, fieldIndex)
+	if bytesErr != nil {
+		return bytesErr
+	}
+
+	switch typedValue := value.(type) {
+	case string:
+		content, encodeErr := dt.encode(typedValue)
+		if encodeErr != nil {
+			return dt.encodingError(row, fieldIndex, encodeErr)
+		}
+		return dt.writeMemo(fieldBytes, content, TextMemoBlock)
+	case []byte:
+		return dt.writeMemo(fieldBytes, typedValue, fieldType.binaryMemoBlockType())
+	default:
+		return fmt.Errorf("memo content of type %T is not supported; use a string or []byte", value)
+	}
+}
+
+// memoValue returns the memo content referenced by the block number held in fieldBytes, the content of the given row
+// and field index. Text content is decoded, and binary content returned undecoded. An empty string is returned if the
+// field references no memo, or the table has no memo file. An error is returned if the memo cannot be read, or strict
+// encoding is on, and text content does not decode.
+func (dt *DbfTable) memoValue(row int, fieldIndex int, fieldBytes []byte) (string, error) {
+	content, blockType, readErr := dt.readMemo(fieldBytes)
+	if readErr != nil {
+		return "", readErr
+	}
+
+	if blockType != TextMemoBlock {
+		return string(content), nil
+	}
+	value, decodeErr := dt.decode(content)
+	return value, dt.encodingError(row, fieldIndex, decodeErr)
+}
+
+// setMemoValue writes value to the table's memo file as text, and stores the block number it was written to in
+// fieldBytes. An empty value clears the field's reference to any memo.
+func (dt *DbfTable) setMemoValue(fieldBytes []byte, value string) error {
+	content, encodeErr := dt.encode(value)
+	if encodeErr != nil {
+		return encodeErr
+	}
+	return dt.writeMemo(fieldBytes, content, TextMemoBlock)
+}
+
+// readMemo returns the raw memo content, and its block type, referenced by the block number held in fieldBytes.
+// Empty content is returned if the field references no memo, or the table has no memo file.
+func (dt *DbfTable) readMemo(fieldBytes []byte) ([]byte, MemoBlockType, error) {
+	block, blockErr := memoBlockNumber(fieldBytes)
+	if blockErr != nil {
+		return nil, TextMemoBlock, blockErr
+	}
+
+	if block == 0 || dt.memo == nil {
+		return nil, TextMemoBlock, nil
+	}
+
+	return dt.memo.readMemo(block)
+}
+
+// writeMemo writes raw content to the table's memo file, and stores the block number it was written to in
+// fieldBytes. Empty content clears the field's reference to any memo.
+func (dt *DbfTable) writeMemo(fieldBytes []byte, content []byte, blockType MemoBlockType) error {
+	if dt.memo == nil {
+		return errors.New("table has no memo file to store memo field content in")
+	}
+
+	if len(content) == 0 {
+		setMemoBlockNumber(fieldBytes, 0)
+		return nil
+	}
+
+	block, writeErr := dt.memo.writeMemo(content, blockType)
+	if writeErr != nil {
+		return writeErr
+	}
+
+	return setMemoBlockNumber(fieldBytes, block)
+}
+
+// memoBlockNumber interprets a memo field's content as the block number it references. Fields of length
+// binaryMemoReferenceLength hold a little-endian integer, and all others the ASCII digits dBase stores.
+func memoBlockNumber(fieldBytes []byte) (uint32, error) {
+	if len(fieldBytes) == binaryMemoReferenceLength {
+		return binary.LittleEndian.Uint32(fieldBytes), nil
+	}
+
+	trimmed := strings.Trim(string(fieldBytes), " \x00")
+	if trimmed == "" {
+		return 0, nil
+	}
+
+	block, parseErr := strconv.ParseUint(trimmed, 10, 32)
+	if parseErr != nil {
+		return 0, fmt.Errorf("memo field content %q is not a block number", trimmed)
+	}
+	return uint32(block), nil
+}
+
+// setMemoBlockNumber stores the block number in a memo field, in the encoding memoBlockNumber expects. A block number
+// of 0 clears the field.
+func setMemoBlockNumber(fieldBytes []byte, block uint32) error {
+	if len(fieldBytes) == binaryMemoReferenceLength {
+		binary.LittleEndian.PutUint32(fieldBytes, block)
+		return nil
+	}
+
+	fillWithBlanks(fieldBytes)
+	if block == 0 {
+		return nil
+	}
+
+	blockBytes := []byte(strconv.FormatUint(uint64(block), 10))
+	if len(blockBytes) > len(fieldBytes) {
+		return fmt.Errorf("memo block number %d does not fit in a field of length %d", block, len(fieldBytes))
+	}
+	copy(fieldBytes[len(fieldBytes)-len(blockBytes):], blockBytes)
+
+	return nil
+}
@@ -0,0 +1,351 @@
+package godbf
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDbfTable_AddMemoField(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	expectedFieldName := "testMemo"
+	additionError := tableUnderTest.AddMemoField(expectedFieldName)
+	g.Expect(additionError).To(BeNil())
+
+	addedField := tableUnderTest.Fields()[0]
+	g.Expect(addedField.name).To(Equal(expectedFieldName))
+	g.Expect(addedField.fieldType).To(Equal(Memo))
+	g.Expect(addedField.length).To(BeNumerically("==", 10))
+
+	g.Expect(tableUnderTest.fileSignature).To(Equal(dbaseIIIWithMemoFileSignature))
+	g.Expect(tableUnderTest.dataStore[0]).To(Equal(dbaseIIIWithMemoFileSignature))
+	g.Expect(tableUnderTest.memo).ToNot(BeNil())
+}
+
+func TestDbfTable_AddMemoField_ErrorAfterDataEntryStart(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	tableUnderTest.AddTextField("textField", 10)
+	tableUnderTest.AddNewRecord()
+
+	additionError := tableUnderTest.AddMemoField("memoField")
+	g.Expect(additionError).ToNot(BeNil())
+	g.Expect(tableUnderTest.memo).To(BeNil())
+	g.Expect(tableUnderTest.fileSignature).To(Equal(dbaseIIIFileSignature))
+}
+
+func TestDbfTable_MemoFieldValue(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+
+	const memoFieldName = "memoField"
+	tableUnderTest.AddMemoField(memoFieldName)
+
+	expectedShortMemo := "a short memo"
+	expectedLongMemo := string(make([]byte, 1500)) + "spanning multiple blocks"
+
+	firstRecord, _ := tableUnderTest.AddNewRecord()
+	secondRecord, _ := tableUnderTest.AddNewRecord()
+	emptyRecord, _ := tableUnderTest.AddNewRecord()
+
+	g.Expect(tableUnderTest.SetFieldValueByName(firstRecord, memoFieldName, expectedShortMemo)).To(Succeed())
+	g.Expect(tableUnderTest.SetFieldValueByName(secondRecord, memoFieldName, expectedLongMemo)).To(Succeed())
+
+	g.Expect(tableUnderTest.FieldValueByName(firstRecord, memoFieldName)).To(Equal(expectedShortMemo))
+	g.Expect(tableUnderTest.FieldValueByName(secondRecord, memoFieldName)).To(Equal(expectedLongMemo))
+	g.Expect(tableUnderTest.FieldValueByName(emptyRecord, memoFieldName)).To(Equal(""))
+
+	g.Expect(tableUnderTest.FieldValueByName(firstRecord, memoFieldName)).To(Equal(expectedShortMemo))
+}
+
+func TestDbfTable_MemoFieldValue_NoMemoFile_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+
+	const memoFieldName = "memoField"
+	tableUnderTest.AddMemoField(memoFieldName)
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+	tableUnderTest.memo = nil
+
+	setError := tableUnderTest.SetFieldValueByName(recordIndex, memoFieldName, "some memo")
+	g.Expect(setError).ToNot(BeNil())
+	t.Log(setError)
+
+	g.Expect(tableUnderTest.FieldValueByName(recordIndex, memoFieldName)).To(Equal(""))
+}
+
+func TestDbfTable_FieldValue_MemoBlockMissing_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+
+	const memoFieldName = "memoField"
+	tableUnderTest.AddMemoField(memoFieldName)
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+	g.Expect(tableUnderTest.SetFieldValueByName(recordIndex, memoFieldName, "some memo")).To(Succeed())
+
+	fieldBytes, _ := tableUnderTest.fieldBytes(recordIndex, 0)
+	g.Expect(setMemoBlockNumber(fieldBytes, 9999)).To(Succeed())
+
+	_, valueErr := tableUnderTest.FieldValueByName(recordIndex, memoFieldName)
+	g.Expect(valueErr).ToNot(BeNil())
+	t.Log(valueErr)
+}
+
+func TestDbtMemo_WriteMemo_StaleNextFreeBlock_EarlierMemosKept(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	for _, version := range []dbtVersion{dbaseIIIMemo, dbaseIVMemo} {
+		memoUnderTest := newDbtMemo(version)
+		firstBlock, _ := memoUnderTest.writeMemo([]byte("first"), TextMemoBlock)
+		secondBlock, _ := memoUnderTest.writeMemo([]byte("second"), TextMemoBlock)
+		header := bytes.Clone(memoUnderTest.bytes()[4:dbtDefaultBlockSize])
+
+		for _, staleBlock := range []uint32{firstBlock, 0} {
+			memoUnderTest.setNextFreeBlock(staleBlock)
+			thirdBlock, writeErr := memoUnderTest.writeMemo([]byte("third"), TextMemoBlock)
+			g.Expect(writeErr).To(BeNil())
+			g.Expect(thirdBlock).To(BeNumerically(">", secondBlock))
+
+			for block, expected := range map[uint32]string{firstBlock: "first", secondBlock: "second", thirdBlock: "third"} {
+				content, _, readErr := memoUnderTest.readMemo(block)
+				g.Expect(readErr).To(BeNil())
+				g.Expect(string(content)).To(Equal(expected))
+			}
+			g.Expect(memoUnderTest.bytes()[4:dbtDefaultBlockSize]).To(Equal(header))
+		}
+	}
+}
+
+func TestDbfTable_MemoFieldValue_InvalidIndexes_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	tableUnderTest.AddMemoField("memoField")
+	tableUnderTest.AddNewRecord()
+
+	for _, invalid := range []struct {
+		row, fieldIndex int
+		expectedErr     error
+	}{
+		{1, 0, ErrRowOutOfBounds},
+		{-1, 0, ErrRowOutOfBounds},
+		{0, 1, ErrFieldNotFound},
+		{0, -1, ErrFieldNotFound},
+	} {
+		_, valueErr := tableUnderTest.MemoFieldValue(invalid.row, invalid.fieldIndex)
+		g.Expect(errors.Is(valueErr, invalid.expectedErr)).To(BeTrue())
+		g.Expect(errors.Is(tableUnderTest.SetMemoFieldValue(invalid.row, invalid.fieldIndex, "memo"), invalid.expectedErr)).To(BeTrue())
+	}
+}
+
+func TestSaveToFile_WithMemo_LoadOfSavedIsCorrect(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableToSave := New(testEncoding)
+
+	const textFieldName = "TEXTFIELD"
+	const memoFieldName = "MEMOFIELD"
+	tableToSave.AddTextField(textFieldName, 10)
+	tableToSave.AddMemoField(memoFieldName)
+
+	expectedMemos := []string{"first memo", "", "third memo"}
+	for _, memo := range expectedMemos {
+		recordIndex, _ := tableToSave.AddNewRecord()
+		tableToSave.SetFieldValueByName(recordIndex, textFieldName, "text")
+		tableToSave.SetFieldValueByName(recordIndex, memoFieldName, memo)
+	}
+
+	tempFilename := filepath.Join("testdata", "tempSavedMemoTable.DBF")
+	tempMemoFilename := filepath.Join("testdata", "tempSavedMemoTable.DBT")
+
+	saveErr := SaveToFile(tableToSave, tempFilename)
+	g.Expect(saveErr).To(BeNil())
+
+	_, statErr := os.Stat(tempMemoFilename)
+	g.Expect(statErr).To(BeNil())
+
+	tableUnderTest, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+
+	g.Expect(tableUnderTest.fileSignature).To(Equal(dbaseIIIWithMemoFileSignature))
+	g.Expect(tableUnderTest.Fields()[1].FieldType()).To(Equal(Memo))
+	for recordIndex, expectedMemo := range expectedMemos {
+		g.Expect(tableUnderTest.FieldValueByName(recordIndex, memoFieldName)).To(Equal(expectedMemo))
+	}
+
+	g.Expect(os.Remove(tempFilename)).To(Succeed())
+	g.Expect(os.Remove(tempMemoFilename)).To(Succeed())
+}
+
+func TestNewFromFile_MemoFileMissing_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableToSave := New(testEncoding)
+	tableToSave.AddMemoField("MEMOFIELD")
+
+	tempFilename := filepath.Join("testdata", "tempMissingMemoTable.dbf")
+	g.Expect(SaveToFile(tableToSave, tempFilename)).To(Succeed())
+	g.Expect(os.Remove(filepath.Join("testdata", "tempMissingMemoTable.dbt"))).To(Succeed())
+
+	_, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).ToNot(BeNil())
+	t.Log(loadErr)
+
+	g.Expect(os.Remove(tempFilename)).To(Succeed())
+}
+
+func TestNewFromByteArrayWithMemo_DbaseIVMemo(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableToEncode := New(testEncoding)
+	const memoFieldName = "MEMOFIELD"
+	tableToEncode.AddMemoField(memoFieldName)
+
+	dbaseIVMemoStore := newDbtMemo(dbaseIVMemo)
+	tableToEncode.memo = dbaseIVMemoStore
+	tableToEncode.fileSignature = dbaseIVWithMemoFileSignature
+	tableToEncode.dataStore[0] = dbaseIVWithMemoFileSignature
+
+	expectedMemo := "a dBase IV memo"
+	recordIndex, _ := tableToEncode.AddNewRecord()
+	tableToEncode.SetFieldValueByName(recordIndex, memoFieldName, expectedMemo)
+
+	g.Expect(dbaseIVMemoStore.bytes()[512:516]).To(Equal(dbaseIVMemoMarker))
+
+	tableUnderTest, newErr := NewFromByteArrayWithMemo(tableToEncode.dataStore, dbaseIVMemoStore.bytes(), testEncoding)
+	g.Expect(newErr).To(BeNil())
+
+	g.Expect(tableUnderTest.FieldValueByName(recordIndex, memoFieldName)).To(Equal(expectedMemo))
+}
+
+func TestNewFromByteArrayWithMemo_NoMemoSignature_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawFileBytes, loadErr := os.ReadFile(validTestFile)
+	g.Expect(loadErr).To(BeNil())
+
+	_, newErr := NewFromByteArrayWithMemo(rawFileBytes, newDbtMemo(dbaseIIIMemo).bytes(), testEncoding)
+	g.Expect(newErr).ToNot(BeNil())
+	t.Log(newErr)
+}
+
+func TestDbfTable_AddGeneralField_DbaseMemo_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	tableUnderTest.AddMemoField("memoField")
+
+	additionError := tableUnderTest.AddGeneralField("objField")
+	g.Expect(additionError).ToNot(BeNil())
+	g.Expect(len(tableUnderTest.Fields())).To(Equal(1))
+	t.Log(additionError)
+}
+
+func TestDbfTable_UseFoxProMemo_AfterMemoField_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	tableUnderTest.AddMemoField("memoField")
+
+	useError := tableUnderTest.UseFoxProMemo(64)
+	g.Expect(useError).ToNot(BeNil())
+	t.Log(useError)
+}
+
+func TestDbfTable_MemoFieldValue_TextAndBinary(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	g.Expect(tableUnderTest.UseFoxProMemo(32)).To(Succeed())
+	g.Expect(tableUnderTest.fileSignature).To(Equal(foxProWithMemoFileSignature))
+
+	const memoFieldName = "memoField"
+	const generalFieldName = "objField"
+	const pictureFieldName = "picField"
+	g.Expect(tableUnderTest.AddMemoField(memoFieldName)).To(Succeed())
+	g.Expect(tableUnderTest.AddGeneralField(generalFieldName)).To(Succeed())
+	g.Expect(tableUnderTest.AddPictureField(pictureFieldName)).To(Succeed())
+
+	expectedText := "some memo text"
+	expectedObject := []byte{0xD0, 0xCF, 0x11, 0xE0, 0x1A, 0x00}
+	expectedPicture := []byte{0x89, 'P', 'N', 'G'}
+
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+	g.Expect(tableUnderTest.SetMemoFieldValueByName(recordIndex, memoFieldName, expectedText)).To(Succeed())
+	g.Expect(tableUnderTest.SetMemoFieldValueByName(recordIndex, generalFieldName, expectedObject)).To(Succeed())
+	g.Expect(tableUnderTest.SetMemoFieldValueByName(recordIndex, pictureFieldName, expectedPicture)).To(Succeed())
+
+	g.Expect(tableUnderTest.MemoFieldValueByName(recordIndex, memoFieldName)).To(Equal(expectedText))
+	g.Expect(tableUnderTest.MemoFieldValueByName(recordIndex, generalFieldName)).To(Equal(expectedObject))
+	g.Expect(tableUnderTest.MemoFieldValueByName(recordIndex, pictureFieldName)).To(Equal(expectedPicture))
+
+	g.Expect(tableUnderTest.FieldValueByName(recordIndex, memoFieldName)).To(Equal(expectedText))
+}
+
+func TestDbfTable_MemoFieldValue_NotMemoField_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	const textFieldName = "textField"
+	tableUnderTest.AddTextField(textFieldName, 10)
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+
+	_, getError := tableUnderTest.MemoFieldValueByName(recordIndex, textFieldName)
+	g.Expect(getError).ToNot(BeNil())
+
+	setError := tableUnderTest.SetMemoFieldValueByName(recordIndex, textFieldName, "text")
+	g.Expect(setError).ToNot(BeNil())
+	t.Log(setError)
+}
+
+func TestSaveToFile_WithFoxProMemo_LoadOfSavedIsCorrect(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableToSave := New(testEncoding)
+	const generalFieldName = "OBJFIELD"
+	const memoFieldName = "MEMOFIELD"
+	tableToSave.AddGeneralField(generalFieldName)
+	tableToSave.AddMemoField(memoFieldName)
+
+	expectedText := "some memo text"
+	expectedObject := []byte{0x01, 0x02, 0x03}
+
+	recordIndex, _ := tableToSave.AddNewRecord()
+	tableToSave.SetMemoFieldValueByName(recordIndex, memoFieldName, expectedText)
+	tableToSave.SetMemoFieldValueByName(recordIndex, generalFieldName, expectedObject)
+
+	tempFilename := filepath.Join("testdata", "tempSavedFoxProTable.dbf")
+	tempMemoFilename := filepath.Join("testdata", "tempSavedFoxProTable.fpt")
+
+	g.Expect(SaveToFile(tableToSave, tempFilename)).To(Succeed())
+
+	tableUnderTest, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+
+	g.Expect(tableUnderTest.fileSignature).To(Equal(foxProWithMemoFileSignature))
+	g.Expect(tableUnderTest.Fields()[0].FieldType()).To(Equal(General))
+	g.Expect(tableUnderTest.MemoFieldValueByName(recordIndex, memoFieldName)).To(Equal(expectedText))
+	g.Expect(tableUnderTest.MemoFieldValueByName(recordIndex, generalFieldName)).To(Equal(expectedObject))
+
+	g.Expect(os.Remove(tempFilename)).To(Succeed())
+	g.Expect(os.Remove(tempMemoFilename)).To(Succeed())
+}
+
+func TestMemoBlockNumber_BinaryReference(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	fieldBytes := make([]byte, binaryMemoReferenceLength)
+	g.Expect(setMemoBlockNumber(fieldBytes, 0x01020304)).To(Succeed())
+	g.Expect(fieldBytes).To(Equal([]byte{0x04, 0x03, 0x02, 0x01}))
+	g.Expect(memoBlockNumber(fieldBytes)).To(BeEquivalentTo(0x01020304))
+}
@@ -0,0 +1,189 @@
+package godbf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// tableMapping holds the file a table was opened from with OpenMapped, and the content of it the table reads from.
+type tableMapping struct {
+	fileName string
+	file     file
+	data     []byte
+	mapped   bool // whether data is a memory mapping of file, rather than a copy of its content
+
+	superseded [][]byte     // mappings replaced by Remap, kept until Close, as records read from them may still be in use
+	lock       sync.RWMutex // guards the table's records, their count and its update date while Remap replaces them
+}
+
+// OpenMapped creates a read-only DbfTable from the file with the given file name, expecting the supplied encoding.
+// Where the platform supports it, the file is memory-mapped, and records are read directly from the mapping rather
+// than from a copy of the file held in memory. Otherwise, or if mapping the file fails, the file is read into memory
+// as NewFromFile does. Any memo file is read into memory.
+//
+// Reading from the table is safe for concurrent use, but changing it returns an error. Tables opened with
+// OpenMapped must be closed with Close once no longer needed, after which they must not be read from.
+func OpenMapped(fileName string, fileEncoding string) (*DbfTable, error) {
+	f, openErr := fsWrapper.Open(fileName)
+	if openErr != nil {
+		return nil, openErr
+	}
+
+	dt, loadErr := (&tableMapping{fileName: fileName, file: f}).load(withCodePageFile(fileName, fileEncoding))
+	if loadErr != nil {
+		f.Close()
+		return nil, loadErr
+	}
+
+	if memoErr := loadMemoFile(dt, fileName); memoErr != nil {
+		dt.mapping.close()
+		return nil, memoErr
+	}
+
+	return dt, nil
+}
+
+// IsMapped returns whether the table reads its records from a memory mapping of its file, as opened by OpenMapped.
+func (dt *DbfTable) IsMapped() bool {
+	return dt.mapping != nil && dt.mapping.mapped
+}
+
+// Remap refreshes a table opened with OpenMapped from its file, such as after records have been added to it by
+// another process. If the file has been truncated below the length its header expects, or its fields have changed,
+// an error is returned and the table continues to read from its previous mapping.
+//
+// Remap may be called while the table is being read from. The previous mapping is kept until the table is closed, so
+// that values being read from it stay valid.
+func (dt *DbfTable) Remap() error {
+	if dt.mapping == nil {
+		return errors.New("table was not opened with OpenMapped")
+	}
+
+	refreshed, loadErr := dt.mapping.load(dt.textEncoding)
+	if loadErr != nil {
+		return loadErr
+	}
+
+	if refreshed.numberOfBytesInHeader != dt.numberOfBytesInHeader || refreshed.lengthOfEachRecord != dt.lengthOfEachRecord {
+		refreshed.mapping.release(refreshed.mapping.data)
+		return fmt.Errorf("fields of table file %q have changed since it was opened", dt.mapping.fileName)
+	}
+
+	dt.mapping.lock.Lock()
+	defer dt.mapping.lock.Unlock()
+
+	if dt.mapping.mapped {
+		dt.mapping.superseded = append(dt.mapping.superseded, dt.mapping.data)
+	}
+
+	dt.dataStore = refreshed.dataStore
+	dt.eofMarker = refreshed.eofMarker
+	dt.numberOfRecords = refreshed.numberOfRecords
+	dt.updateYear, dt.updateMonth, dt.updateDay = refreshed.updateYear, refreshed.updateMonth, refreshed.updateDay
+	dt.mapping.data, dt.mapping.mapped = refreshed.mapping.data, refreshed.mapping.mapped
+	return nil
+}
+
+// lockForReading locks the records of a table opened with OpenMapped, their count and its update date for reading, so
+// that Remap cannot replace them until the function returned is called. For other tables, nothing is locked.
+func (dt *DbfTable) lockForReading() (unlock func()) {
+	if dt.mapping == nil {
+		return func() {}
+	}
+	dt.mapping.lock.RLock()
+	return dt.mapping.lock.RUnlock
+}
+
+// load maps (or failing that, reads) the current content of the mapping's file, and creates a table reading from it.
+// The mapping of the table returned holds the new content; the receiver is left unchanged.
+func (m *tableMapping) load(fileEncoding string) (table *DbfTable, loadErr error) {
+	info, statErr := m.file.Stat()
+	if statErr != nil {
+		return nil, statErr
+	}
+
+	refreshed := &tableMapping{fileName: m.fileName, file: m.file}
+	refreshed.data, refreshed.mapped = mapFileContent(m.file, info.Size())
+	if !refreshed.mapped {
+		refreshed.data = make([]byte, info.Size())
+		if _, readErr := io.ReadFull(io.NewSectionReader(m.file, 0, info.Size()), refreshed.data); readErr != nil {
+			return nil, readErr
+		}
+	}
+
+	defer func() {
+		if loadErr != nil {
+			refreshed.release(refreshed.data)
+		}
+	}()
+
+	dt := new(DbfTable)
+	if encodingErr := dt.useFileEncoding(fileEncoding, refreshed.data); encodingErr != nil {
+		return nil, encodingErr
+	}
+	if headerErr := unpackHeader(refreshed.data, dt); headerErr != nil {
+		return nil, fmt.Errorf("table file %q: %w", m.fileName, headerErr)
+	}
+
+	// Records added by another process may be in the file before its header counts them, so only a shortfall is
+	// reported.
+	expectedSize := int(dt.numberOfBytesInHeader) + int(dt.numberOfRecords)*int(dt.lengthOfEachRecord) + 1
+	if len(refreshed.data) < expectedSize {
+		return nil, fmt.Errorf("table file %q: %w", m.fileName, verifyContentLength(int64(len(refreshed.data)), int64(expectedSize)))
+	}
+
+	dt.dataStore = refreshed.data[:expectedSize]
+	dt.eofMarker = dt.dataStore[expectedSize-1]
+	dt.mapping = refreshed
+	lockSchema(dt)
+
+	return dt, nil
+}
+
+// mapFileContent memory-maps the given number of bytes of the file, returning whether it could.
+func mapFileContent(f file, size int64) ([]byte, bool) {
+	if size <= 0 || int64(int(size)) != size {
+		return nil, false
+	}
+	data, mapErr := mapFile(f, int(size))
+	if mapErr != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// release unmaps the given content of the mapping's file, if it is mapped.
+func (m *tableMapping) release(data []byte) {
+	if m.mapped {
+		unmapFile(data)
+	}
+}
+
+// close unmaps the mapping's content, if it is mapped, and any mappings it superseded, and closes its file.
+func (m *tableMapping) close() error {
+	var unmapErr error
+	if m.mapped {
+		unmapErr = unmapFile(m.data)
+	}
+	for _, data := range m.superseded {
+		if supersededErr := unmapFile(data); supersededErr != nil && unmapErr == nil {
+			unmapErr = supersededErr
+		}
+	}
+	m.data, m.mapped, m.superseded = nil, false, nil
+
+	if closeErr := m.file.Close(); closeErr != nil && unmapErr == nil {
+		return closeErr
+	}
+	return unmapErr
+}
+
+// verifyWritable returns an error if the table cannot be changed, having been opened with OpenMapped.
+func (dt *DbfTable) verifyWritable() error {
+	if dt.mapping != nil {
+		return fmt.Errorf("%w: opened with OpenMapped", ErrReadOnly)
+	}
+	return nil
+}
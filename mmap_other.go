@@ -0,0 +1,15 @@
+//go:build !unix
+
+package godbf
+
+import "errors"
+
+// mapFile reports that memory-mapping files is not supported on this platform.
+func mapFile(f file, size int) ([]byte, error) {
+	return nil, errors.ErrUnsupported
+}
+
+// unmapFile does nothing, as mapFile never maps files on this platform.
+func unmapFile(data []byte) error {
+	return nil
+}
@@ -0,0 +1,239 @@
+package godbf
+
+import (
+	"bytes"
+	"errors"
+	. "github.com/onsi/gomega"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestOpenMapped_ValidFile_TableIsCorrect(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, openErr := OpenMapped(validTestFile, testEncoding)
+	g.Expect(openErr).To(BeNil())
+	defer tableUnderTest.Close()
+
+	verifyTableIsCorrect(tableUnderTest, g)
+}
+
+func TestOpenMapped_MissingFile_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, openErr := OpenMapped(filepath.Join("testdata", "missingFile.dbf"), testEncoding)
+	g.Expect(openErr).To(Not(BeNil()))
+}
+
+func TestOpenMapped_TruncatedFile_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tempFilename := filepath.Join("testdata", "tempTruncatedTable.dbf")
+	copyValidTestFile(g, tempFilename)
+	defer os.Remove(tempFilename)
+	g.Expect(os.Truncate(tempFilename, 100)).To(Succeed())
+
+	_, openErr := OpenMapped(tempFilename, testEncoding)
+	g.Expect(openErr).To(Not(BeNil()))
+}
+
+func TestOpenMapped_FileCannotBeMapped_FallsBackToReadingFile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	fsWrapper = unmappableFileSystem{}
+	defer func() { fsWrapper = osFileSystem{} }()
+
+	tableUnderTest, openErr := OpenMapped(validTestFile, testEncoding)
+	g.Expect(openErr).To(BeNil())
+	defer tableUnderTest.Close()
+
+	g.Expect(tableUnderTest.IsMapped()).To(BeFalse())
+	verifyTableIsCorrect(tableUnderTest, g)
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "TESTTEXT", "changed")).To(Not(Succeed()))
+}
+
+type unmappableFileSystem struct {
+	osFileSystem
+}
+
+// unmappableFile hides the descriptor of the file it wraps, so that it cannot be memory-mapped.
+type unmappableFile struct {
+	file
+}
+
+func (unmappableFileSystem) Open(name string) (file, error) {
+	f, openErr := os.Open(name)
+	if openErr != nil {
+		return nil, openErr
+	}
+	return unmappableFile{f}, nil
+}
+
+func TestDbfTable_SetFieldValue_OpenMapped_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := OpenMapped(validTestFile, testEncoding)
+	defer tableUnderTest.Close()
+
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "TESTTEXT", "changed")).To(Not(Succeed()))
+	g.Expect(tableUnderTest.SetRowIsDeleted(0)).To(Not(Succeed()))
+
+	_, addErr := tableUnderTest.AddNewRecord()
+	g.Expect(addErr).To(Not(BeNil()))
+}
+
+func TestDbfTable_FieldValue_OpenMappedConcurrentReaders_MatchesNewFromFile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	expectedTable, _ := NewFromFile(validTestFile, testEncoding)
+	tableUnderTest, _ := OpenMapped(validTestFile, testEncoding)
+	defer tableUnderTest.Close()
+
+	var readers sync.WaitGroup
+	rows := make([][][]string, 8)
+	for reader := range rows {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for row := 0; row < tableUnderTest.NumberOfRecords(); row++ {
+				rows[reader] = append(rows[reader], tableUnderTest.GetRowAsSlice(row))
+			}
+		}()
+	}
+	readers.Wait()
+
+	for _, readerRows := range rows {
+		for row, values := range readerRows {
+			g.Expect(values).To(Equal(expectedTable.GetRowAsSlice(row)))
+		}
+	}
+}
+
+func TestDbfTable_Remap_RecordAddedToFile_TableIncludesRecord(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tempFilename := filepath.Join("testdata", "tempRemappedTable.dbf")
+	copyValidTestFile(g, tempFilename)
+	defer os.Remove(tempFilename)
+
+	tableUnderTest, openErr := OpenMapped(tempFilename, testEncoding)
+	g.Expect(openErr).To(BeNil())
+	defer tableUnderTest.Close()
+	originalRecords := tableUnderTest.NumberOfRecords()
+
+	appendingTable, _ := OpenFile(tempFilename, testEncoding)
+	newRow, _ := appendingTable.AddNewRecord()
+	g.Expect(appendingTable.SetFieldValueByName(newRow, "TESTTEXT", "appended")).To(Succeed())
+	g.Expect(appendingTable.Close()).To(Succeed())
+
+	g.Expect(tableUnderTest.Remap()).To(Succeed())
+
+	g.Expect(tableUnderTest.NumberOfRecords()).To(Equal(originalRecords + 1))
+	g.Expect(tableUnderTest.FieldValueByName(newRow, "TESTTEXT")).To(Equal("appended"))
+}
+
+func TestDbfTable_Remap_ConcurrentReaders_ValuesUnchanged(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tempFilename := filepath.Join(t.TempDir(), "remapped.dbf")
+	copyValidTestFile(g, tempFilename)
+
+	expectedTable, _ := NewFromFile(tempFilename, testEncoding)
+	tableUnderTest, openErr := OpenMapped(tempFilename, testEncoding)
+	g.Expect(openErr).To(BeNil())
+	defer tableUnderTest.Close()
+
+	var readers sync.WaitGroup
+	mismatches := make([]int, 4)
+	for reader := range mismatches {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for range 50 {
+				for row := 0; row < tableUnderTest.NumberOfRecords(); row++ {
+					if !slices.Equal(tableUnderTest.GetRowAsSlice(row), expectedTable.GetRowAsSlice(row)) {
+						mismatches[reader]++
+					}
+				}
+			}
+		}()
+	}
+	for range 50 {
+		g.Expect(tableUnderTest.Remap()).To(Succeed())
+	}
+	readers.Wait()
+
+	g.Expect(mismatches).To(Equal(make([]int, 4)))
+}
+
+func TestDbfTable_Remap_ConcurrentHeaderReadersAndWriters_ContentUnchanged(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tempFilename := filepath.Join(t.TempDir(), "remapped.dbf")
+	copyValidTestFile(g, tempFilename)
+	expectedContent, _ := os.ReadFile(tempFilename)
+
+	tableUnderTest, openErr := OpenMapped(tempFilename, testEncoding)
+	g.Expect(openErr).To(BeNil())
+	defer tableUnderTest.Close()
+	expectedUpdate := tableUnderTest.LastUpdated()
+
+	var readers sync.WaitGroup
+	mismatches := make([]int, 4)
+	for reader := range mismatches {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for range 50 {
+				var content bytes.Buffer
+				if writeContent(tableUnderTest, &content) != nil || !bytes.Equal(content.Bytes(), expectedContent) {
+					mismatches[reader]++
+				}
+				if !tableUnderTest.LastUpdated().Equal(expectedUpdate) || tableUnderTest.LanguageDriver() != 0 {
+					mismatches[reader]++
+				}
+				if _, writerErr := NewWriterWithCount(io.Discard, tableUnderTest, 0); writerErr != nil {
+					mismatches[reader]++
+				}
+			}
+		}()
+	}
+	for range 50 {
+		g.Expect(tableUnderTest.Remap()).To(Succeed())
+	}
+	readers.Wait()
+
+	g.Expect(mismatches).To(Equal(make([]int, 4)))
+}
+
+func TestDbfTable_Remap_FileTruncated_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tempFilename := filepath.Join("testdata", "tempRemapTruncatedTable.dbf")
+	copyValidTestFile(g, tempFilename)
+	defer os.Remove(tempFilename)
+
+	tableUnderTest, openErr := OpenMapped(tempFilename, testEncoding)
+	g.Expect(openErr).To(BeNil())
+	defer tableUnderTest.Close()
+	originalRecords := tableUnderTest.NumberOfRecords()
+
+	g.Expect(os.Truncate(tempFilename, int64(tableUnderTest.numberOfBytesInHeader)+1)).To(Succeed())
+
+	remapErr := tableUnderTest.Remap()
+	g.Expect(remapErr).To(Not(BeNil()))
+	g.Expect(errors.Is(remapErr, ErrTruncated)).To(BeTrue())
+	g.Expect(tableUnderTest.NumberOfRecords()).To(Equal(originalRecords))
+}
+
+func TestDbfTable_Remap_NotOpenMapped_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromFile(validTestFile, testEncoding)
+
+	g.Expect(tableUnderTest.Remap()).To(Not(Succeed()))
+}
@@ -0,0 +1,22 @@
+//go:build unix
+
+package godbf
+
+import (
+	"errors"
+	"syscall"
+)
+
+// mapFile memory-maps the first size bytes of the file for reading.
+func mapFile(f file, size int) ([]byte, error) {
+	descriptor, isOsFile := f.(interface{ Fd() uintptr })
+	if !isOsFile {
+		return nil, errors.New("file has no descriptor to map")
+	}
+	return syscall.Mmap(int(descriptor.Fd()), 0, size, syscall.PROT_READ, syscall.MAP_SHARED)
+}
+
+// unmapFile releases a memory mapping made by mapFile.
+func unmapFile(data []byte) error {
+	return syscall.Munmap(data)
+}
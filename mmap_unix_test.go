@@ -0,0 +1,19 @@
+//go:build unix
+
+package godbf
+
+import (
+	. "github.com/onsi/gomega"
+	"testing"
+)
+
+func TestOpenMapped_ValidFile_IsMapped(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, openErr := OpenMapped(validTestFile, testEncoding)
+	g.Expect(openErr).To(BeNil())
+
+	g.Expect(tableUnderTest.IsMapped()).To(BeTrue())
+	g.Expect(tableUnderTest.Close()).To(Succeed())
+	g.Expect(tableUnderTest.IsMapped()).To(BeFalse())
+}
@@ -0,0 +1,148 @@
+package godbf
+
+import (
+	"io"
+	"os"
+)
+
+// OpenOption configures how OpenFile opens a table.
+type OpenOption func(*openSettings)
+
+type openSettings struct {
+	readOnly       bool
+	cachePages     int
+	recordsPerPage int
+}
+
+// WithReadOnly opens the table file for reading only. Changes made to the table's records are reported as errors
+// when the table is flushed or closed.
+func WithReadOnly() OpenOption {
+	return func(settings *openSettings) {
+		settings.readOnly = true
+	}
+}
+
+// WithPageCache caches up to the given number of pages of records in memory, each page holding the given number of
+// consecutive records, evicting the least recently used page when the cache is full. Without it, only the record
+// most recently asked for is held in memory.
+func WithPageCache(pages int, recordsPerPage int) OpenOption {
+	return func(settings *openSettings) {
+		settings.cachePages = pages
+		settings.recordsPerPage = recordsPerPage
+	}
+}
+
+// OpenFile creates a DbfTable backed by the file with the given file name, expecting the supplied encoding. Only the
+// table header is read up front. Records are read as they are asked for, and changes to them, along with records
+// added, are written back to the file in place.
+//
+// Changes may be held in memory until the table is flushed or closed. Tables opened with OpenFile must be closed
+// with Close once no longer needed. Any memo file is read into memory, and rewritten when the table is flushed, if
+// memos have been added.
+func OpenFile(fileName string, fileEncoding string, options ...OpenOption) (table *DbfTable, openErr error) {
+	settings := openSettings{cachePages: 1, recordsPerPage: 1}
+	for _, option := range options {
+		option(&settings)
+	}
+
+	flag := os.O_RDWR
+	if settings.readOnly {
+		flag = os.O_RDONLY
+	}
+
+	f, fileErr := fsWrapper.OpenFile(fileName, flag, 0)
+	if fileErr != nil {
+		return nil, fileErr
+	}
+
+	defer func() {
+		if openErr != nil {
+			f.Close()
+		}
+	}()
+
+	info, statErr := f.Stat()
+	if statErr != nil {
+		return nil, statErr
+	}
+
+	headerBytes, headerErr := readHeader(io.NewSectionReader(f, 0, info.Size()))
+	if headerErr != nil {
+		return nil, headerErr
+	}
+
+	dt := new(DbfTable)
+	if encodingErr := dt.useFileEncoding(withCodePageFile(fileName, fileEncoding), headerBytes); encodingErr != nil {
+		return nil, encodingErr
+	}
+	if unpackErr := unpackHeader(headerBytes, dt); unpackErr != nil {
+		return nil, unpackErr
+	}
+
+	expectedSize := int64(dt.numberOfBytesInHeader) + int64(dt.numberOfRecords)*int64(dt.lengthOfEachRecord) + 1
+	if sizeErr := verifyContentLength(info.Size(), expectedSize); sizeErr != nil {
+		return nil, sizeErr
+	}
+
+	dt.dataStore = headerBytes
+	dt.eofMarker = eofMarker
+	dt.storage = newFileRecordStorage(f, headerBytes, int(dt.lengthOfEachRecord), int(dt.numberOfRecords),
+		settings.readOnly, newPageCache(settings.cachePages, settings.recordsPerPage))
+	dt.fileName = fileName
+	lockSchema(dt)
+
+	if memoErr := loadMemoFile(dt, fileName); memoErr != nil {
+		return nil, memoErr
+	}
+	if dt.memo != nil {
+		dt.savedMemoLength = len(dt.memo.bytes())
+	}
+
+	return dt, nil
+}
+
+// Flush writes changes held in memory for a table opened with OpenFile back to its file, returning the first error
+// encountered reading or writing records since the table was last flushed. It does nothing for other tables.
+func (dt *DbfTable) Flush() error {
+	if dt.storage == nil {
+		return nil
+	}
+
+	flushErr := dt.storage.flush(dt.dataStore)
+	if memoErr := dt.flushMemo(); memoErr != nil && flushErr == nil {
+		flushErr = memoErr
+	}
+	return flushErr
+}
+
+// Close flushes a table opened with OpenFile, and closes its file, or releases the mapping of a table opened with
+// OpenMapped, and closes its file. It does nothing for other tables.
+func (dt *DbfTable) Close() error {
+	if dt.mapping != nil {
+		closeErr := dt.mapping.close()
+		dt.mapping, dt.dataStore = nil, nil
+		return closeErr
+	}
+	if dt.storage == nil {
+		return nil
+	}
+
+	closeErr := dt.storage.close(dt.dataStore)
+	if memoErr := dt.flushMemo(); memoErr != nil && closeErr == nil {
+		closeErr = memoErr
+	}
+	dt.storage = nil
+	return closeErr
+}
+
+// flushMemo rewrites the memo file of a table opened with OpenFile, if memos have been added since it was read.
+func (dt *DbfTable) flushMemo() error {
+	if dt.memo == nil || len(dt.memo.bytes()) == dt.savedMemoLength {
+		return nil
+	}
+	if saveErr := saveMemoFile(dt, dt.fileName); saveErr != nil {
+		return saveErr
+	}
+	dt.savedMemoLength = len(dt.memo.bytes())
+	return nil
+}
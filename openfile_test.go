@@ -0,0 +1,191 @@
+package godbf
+
+import (
+	"errors"
+	. "github.com/onsi/gomega"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func copyValidTestFile(g *GomegaWithT, tempFilename string) {
+	rawFileBytes, loadErr := os.ReadFile(validTestFile)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(os.WriteFile(tempFilename, rawFileBytes, 0644)).To(Succeed())
+}
+
+func TestOpenFile_ValidFile_TableIsCorrect(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, openErr := OpenFile(validTestFile, testEncoding, WithReadOnly())
+	g.Expect(openErr).To(BeNil())
+
+	verifyTableIsCorrect(tableUnderTest, g)
+	g.Expect(tableUnderTest.Close()).To(Succeed())
+}
+
+func TestOpenFile_WithPageCache_MatchesNewFromFile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	expectedTable, loadErr := NewFromFile(validTestFile, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+
+	tableUnderTest, openErr := OpenFile(validTestFile, testEncoding, WithReadOnly(), WithPageCache(2, 2))
+	g.Expect(openErr).To(BeNil())
+
+	g.Expect(tableUnderTest.NumberOfRecords()).To(Equal(expectedTable.NumberOfRecords()))
+	for row := expectedTable.NumberOfRecords() - 1; row >= 0; row-- {
+		g.Expect(tableUnderTest.GetRowAsSlice(row)).To(Equal(expectedTable.GetRowAsSlice(row)))
+	}
+	g.Expect(tableUnderTest.Close()).To(Succeed())
+}
+
+func TestOpenFile_MissingFile_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, openErr := OpenFile(filepath.Join("testdata", "missingFile.dbf"), testEncoding)
+	g.Expect(openErr).To(Not(BeNil()))
+}
+
+func TestOpenFile_LessThanActualRecords_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, openErr := OpenFile(lessThanActualRecordsFile, testEncoding, WithReadOnly())
+	g.Expect(openErr).To(Not(BeNil()))
+}
+
+func TestDbfTable_SetFieldValue_OpenFile_WrittenBackInPlace(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tempFilename := filepath.Join("testdata", "tempOpenedTable.dbf")
+	copyValidTestFile(g, tempFilename)
+	defer os.Remove(tempFilename)
+
+	tableUnderTest, openErr := OpenFile(tempFilename, testEncoding)
+	g.Expect(openErr).To(BeNil())
+
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "TESTTEXT", "changed")).To(Succeed())
+	lastRow := tableUnderTest.NumberOfRecords() - 1
+	g.Expect(tableUnderTest.SetFieldValueByName(lastRow, "TESTTEXT", "evicted")).To(Succeed())
+	g.Expect(tableUnderTest.Flush()).To(Succeed())
+
+	fileInfo, statErr := os.Stat(tempFilename)
+	g.Expect(statErr).To(BeNil())
+	originalInfo, _ := os.Stat(validTestFile)
+	g.Expect(fileInfo.Size()).To(Equal(originalInfo.Size()))
+
+	reloadedTable, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(reloadedTable.FieldValueByName(0, "TESTTEXT")).To(Equal("changed"))
+	g.Expect(reloadedTable.FieldValueByName(lastRow, "TESTTEXT")).To(Equal("evicted"))
+
+	g.Expect(tableUnderTest.Close()).To(Succeed())
+}
+
+func TestDbfTable_AddNewRecord_OpenFile_AppendedToFile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tempFilename := filepath.Join("testdata", "tempAppendedTable.dbf")
+	copyValidTestFile(g, tempFilename)
+	defer os.Remove(tempFilename)
+
+	tableUnderTest, openErr := OpenFile(tempFilename, testEncoding, WithPageCache(4, 8))
+	g.Expect(openErr).To(BeNil())
+	originalRecords := tableUnderTest.NumberOfRecords()
+
+	newRow, addErr := tableUnderTest.AddNewRecord()
+	g.Expect(addErr).To(BeNil())
+	g.Expect(newRow).To(Equal(originalRecords))
+	g.Expect(tableUnderTest.SetFieldValueByName(newRow, "TESTTEXT", "appended")).To(Succeed())
+	g.Expect(tableUnderTest.Close()).To(Succeed())
+
+	reloadedTable, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(reloadedTable.NumberOfRecords()).To(Equal(originalRecords + 1))
+	g.Expect(reloadedTable.FieldValueByName(newRow, "TESTTEXT")).To(Equal("appended"))
+}
+
+func TestDbfTable_SetFieldValue_OpenFileReadOnly_FlushErrors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, openErr := OpenFile(validTestFile, testEncoding, WithReadOnly())
+	g.Expect(openErr).To(BeNil())
+
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "TESTTEXT", "changed")).To(Succeed())
+	g.Expect(tableUnderTest.Flush()).To(Not(Succeed()))
+
+	_, addErr := tableUnderTest.AddNewRecord()
+	g.Expect(addErr).To(Not(BeNil()))
+
+	g.Expect(tableUnderTest.Close()).To(Not(Succeed()))
+}
+
+func TestSaveToFile_OpenFile_LoadOfSavedIsCorrect(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, openErr := OpenFile(validTestFile, testEncoding, WithReadOnly())
+	g.Expect(openErr).To(BeNil())
+	defer tableUnderTest.Close()
+
+	tempFilename := filepath.Join("testdata", "tempSavedOpenedTable.dbf")
+	g.Expect(SaveToFile(tableUnderTest, tempFilename)).To(Succeed())
+	defer os.Remove(tempFilename)
+
+	savedTable, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+	verifyTableIsCorrect(savedTable, g)
+}
+
+func TestDbfTable_Close_InMemoryTable_NoError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromFile(validTestFile, testEncoding)
+
+	g.Expect(tableUnderTest.Flush()).To(Succeed())
+	g.Expect(tableUnderTest.Close()).To(Succeed())
+}
+
+func TestDbfTable_FieldValue_OpenFileReadFails_ErrorsAndNothingWrittenBack(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tempFilename := filepath.Join(t.TempDir(), "truncatedTable.dbf")
+	copyValidTestFile(g, tempFilename)
+
+	tableUnderTest, openErr := OpenFile(tempFilename, testEncoding, WithPageCache(4, 1))
+	g.Expect(openErr).To(BeNil())
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "TESTTEXT", "changed")).To(Succeed())
+
+	truncatedLength := int64(tableUnderTest.numberOfBytesInHeader) + int64(tableUnderTest.lengthOfEachRecord)
+	g.Expect(os.Truncate(tempFilename, truncatedLength)).To(Succeed())
+
+	lastRow := tableUnderTest.NumberOfRecords() - 1
+	_, valueErr := tableUnderTest.FieldValueByName(lastRow, "TESTTEXT")
+	g.Expect(valueErr).To(Not(BeNil()))
+	g.Expect(tableUnderTest.SetFieldValueByName(lastRow, "TESTTEXT", "lost")).To(Not(Succeed()))
+	_, deletedErr := tableUnderTest.RowIsDeleted(lastRow)
+	g.Expect(deletedErr).To(Not(BeNil()))
+
+	g.Expect(tableUnderTest.Flush()).To(Not(Succeed()))
+
+	fileContent, readErr := os.ReadFile(tempFilename)
+	g.Expect(readErr).To(BeNil())
+	g.Expect(fileContent).To(HaveLen(int(truncatedLength)))
+	g.Expect(string(fileContent)).To(Not(ContainSubstring("changed")))
+
+	_, valueErr = tableUnderTest.FieldValueByName(lastRow, "TESTTEXT")
+	g.Expect(valueErr).To(Not(BeNil()))
+	g.Expect(tableUnderTest.Close()).To(Not(Succeed()))
+}
+
+func TestDbfTable_FieldValueByName_OpenFileRowOutOfRange_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, openErr := OpenFile(validTestFile, testEncoding, WithReadOnly())
+	g.Expect(openErr).To(BeNil())
+
+	for _, row := range []int{-1, tableUnderTest.NumberOfRecords()} {
+		_, valueErr := tableUnderTest.FieldValueByName(row, "TESTTEXT")
+		g.Expect(errors.Is(valueErr, ErrRowOutOfBounds)).To(BeTrue())
+	}
+	g.Expect(tableUnderTest.Close()).To(Succeed())
+}
@@ -0,0 +1,113 @@
+package godbf
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Pack physically removes the records marked as deleted from the table, moving the records that follow them up to
+// close the gaps. The memo file, if the table has one, is rebuilt to hold only the memos of the remaining records.
+//
+// The mapping returned holds, for each row before packing, its row number after packing, or -1 if it was removed.
+// Tables opened with OpenFile or OpenMapped cannot be packed in place; see PackFile.
+func (dt *DbfTable) Pack() (rowMapping []int, err error) {
+	if writableErr := dt.verifyWritable(); writableErr != nil {
+		return nil, writableErr
+	}
+	if dt.storage != nil {
+		return nil, errors.New("tables opened with OpenFile cannot be packed; use PackFile")
+	}
+
+	var packedMemo memoStore
+	if dt.memo != nil {
+		packedMemo = dt.memo.empty()
+	}
+
+	headerLength := int(dt.numberOfBytesInHeader)
+	recordLength := int(dt.lengthOfEachRecord)
+
+	packedStore := make([]byte, headerLength, len(dt.dataStore))
+	copy(packedStore, dt.dataStore[:headerLength])
+
+	rowMapping = make([]int, dt.NumberOfRecords())
+	packedRows := 0
+	for row := range rowMapping {
+		record, _ := dt.recordBytes(row) // tables with record storage are refused above
+		if record[recordDeletionFlagIndex] == recordIsDeleted {
+			rowMapping[row] = -1
+			continue
+		}
+
+		packedStore = append(packedStore, record...)
+		if packedMemo != nil {
+			packedRecord := packedStore[headerLength+packedRows*recordLength:]
+			if memoErr := dt.copyMemos(record, packedRecord, packedMemo); memoErr != nil {
+				return nil, fmt.Errorf("packing row %d: %w", row, memoErr)
+			}
+		}
+
+		rowMapping[row] = packedRows
+		packedRows++
+	}
+	packedStore = append(packedStore, dt.eofMarker)
+
+	dt.dataStore = packedStore
+	dt.memo = packedMemo
+	dt.numberOfRecords = uint32(packedRows)
+	copy(dt.dataStore[4:8], uint32ToBytes(dt.numberOfRecords))
+
+	return rowMapping, nil
+}
+
+// copyMemos copies the memos referenced by the memo fields of record into the memo file packedMemo, referencing them
+// from the same fields of packedRecord.
+func (dt *DbfTable) copyMemos(record []byte, packedRecord []byte, packedMemo memoStore) error {
+	for fieldIndex, field := range dt.fields {
+		if !dt.storesInMemo(field) {
+			continue
+		}
+
+		offset := dt.fieldRecordOffset(fieldIndex)
+		fieldBytes := record[offset : offset+int(field.length)]
+		packedFieldBytes := packedRecord[offset : offset+int(field.length)]
+
+		content, blockType, readErr := dt.readMemo(fieldBytes)
+		if readErr != nil {
+			return readErr
+		}
+		if len(content) == 0 {
+			setMemoBlockNumber(packedFieldBytes, 0)
+			continue
+		}
+
+		block, writeErr := packedMemo.writeMemo(content, blockType)
+		if writeErr != nil {
+			return writeErr
+		}
+		if setErr := setMemoBlockNumber(packedFieldBytes, block); setErr != nil {
+			return setErr
+		}
+	}
+	return nil
+}
+
+// PackFile packs the table in the file with the given file name, expecting the supplied encoding, as Pack does, and
+// saves it, along with its rebuilt memo file, in place, as SaveToFile does with the supplied options. Should the
+// process crash between replacing the memo file and the table file, restore the previous memo file SaveToFile keeps.
+// The mapping returned holds, for each row before packing, its row number after packing, or -1 if it was removed.
+func PackFile(fileName string, fileEncoding string, options ...SaveOption) ([]int, error) {
+	dt, loadErr := NewFromFile(fileName, fileEncoding)
+	if loadErr != nil {
+		return nil, loadErr
+	}
+
+	rowMapping, packErr := dt.Pack()
+	if packErr != nil {
+		return nil, packErr
+	}
+
+	if saveErr := SaveToFile(dt, fileName, options...); saveErr != nil {
+		return nil, saveErr
+	}
+	return rowMapping, nil
+}
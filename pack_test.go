@@ -0,0 +1,116 @@
+package godbf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDbfTable_Pack_DeletedRowsRemoved(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromFile(validTestFile, testEncoding)
+	expectedTable, _ := NewFromFile(validTestFile, testEncoding)
+	g.Expect(tableUnderTest.SetRowIsDeleted(1)).To(Succeed())
+
+	rowMapping, packErr := tableUnderTest.Pack()
+	g.Expect(packErr).To(BeNil())
+
+	g.Expect(rowMapping).To(Equal([]int{0, -1, 1}))
+	g.Expect(tableUnderTest.NumberOfRecords()).To(Equal(2))
+	g.Expect(tableUnderTest.GetRowAsSlice(0)).To(Equal(expectedTable.GetRowAsSlice(0)))
+	g.Expect(tableUnderTest.GetRowAsSlice(1)).To(Equal(expectedTable.GetRowAsSlice(2)))
+
+	reloadedTable, loadErr := NewFromByteArray(tableUnderTest.dataStore, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(reloadedTable.NumberOfRecords()).To(Equal(2))
+}
+
+func TestDbfTable_Pack_NoDeletedRows_Unchanged(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromFile(validTestFile, testEncoding)
+	originalBytes, _ := os.ReadFile(validTestFile)
+
+	rowMapping, packErr := tableUnderTest.Pack()
+	g.Expect(packErr).To(BeNil())
+
+	g.Expect(rowMapping).To(Equal([]int{0, 1, 2}))
+	g.Expect(tableUnderTest.dataStore).To(Equal(originalBytes))
+}
+
+func TestDbfTable_Pack_MemoRebuilt(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	tableUnderTest.AddTextField("NAME", 10)
+	tableUnderTest.AddMemoField("NOTES")
+	for _, name := range []string{"alice", "bob", "carol"} {
+		row, _ := tableUnderTest.AddNewRecord()
+		tableUnderTest.SetFieldValueByName(row, "NAME", name)
+		tableUnderTest.SetFieldValueByName(row, "NOTES", name+" notes")
+	}
+	memoLength := len(tableUnderTest.memo.bytes())
+	g.Expect(tableUnderTest.SetRowIsDeleted(0)).To(Succeed())
+
+	rowMapping, packErr := tableUnderTest.Pack()
+	g.Expect(packErr).To(BeNil())
+
+	g.Expect(rowMapping).To(Equal([]int{-1, 0, 1}))
+	g.Expect(tableUnderTest.GetRowAsSlice(0)).To(Equal([]string{"bob", "bob notes"}))
+	g.Expect(tableUnderTest.GetRowAsSlice(1)).To(Equal([]string{"carol", "carol notes"}))
+	g.Expect(len(tableUnderTest.memo.bytes())).To(BeNumerically("<", memoLength))
+	g.Expect(string(tableUnderTest.memo.bytes())).ToNot(ContainSubstring("alice notes"))
+}
+
+func TestDbfTable_Pack_FoxProMemoRebuilt(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	g.Expect(tableUnderTest.UseFoxProMemo(32)).To(Succeed())
+	tableUnderTest.AddMemoField("NOTES")
+	for _, note := range []string{"first", "second"} {
+		row, _ := tableUnderTest.AddNewRecord()
+		tableUnderTest.SetMemoFieldValueByName(row, "NOTES", []byte(note))
+	}
+	tableUnderTest.SetRowIsDeleted(0)
+
+	_, packErr := tableUnderTest.Pack()
+	g.Expect(packErr).To(BeNil())
+
+	g.Expect(tableUnderTest.MemoFieldValueByName(0, "NOTES")).To(Equal([]byte("second")))
+}
+
+func TestDbfTable_Pack_OpenMapped_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := OpenMapped(validTestFile, testEncoding)
+	defer tableUnderTest.Close()
+
+	_, packErr := tableUnderTest.Pack()
+	g.Expect(packErr).ToNot(BeNil())
+}
+
+func TestPackFile_DeletedRowsRemovedFromFile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tempFilename := filepath.Join(t.TempDir(), "packed.dbf")
+	copyValidTestFile(g, tempFilename)
+
+	openedTable, _ := OpenFile(tempFilename, testEncoding)
+	g.Expect(openedTable.SetRowIsDeleted(0)).To(Succeed())
+	_, packErr := openedTable.Pack()
+	g.Expect(packErr).ToNot(BeNil())
+	g.Expect(openedTable.Close()).To(Succeed())
+
+	rowMapping, packErr := PackFile(tempFilename, testEncoding)
+	g.Expect(packErr).To(BeNil())
+	g.Expect(rowMapping).To(Equal([]int{-1, 0, 1}))
+
+	packedTable, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(packedTable.NumberOfRecords()).To(Equal(2))
+	g.Expect(packedTable.FieldValueByName(0, "TESTTEXT")).To(Equal("test1"))
+}
@@ -0,0 +1,178 @@
+package godbf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// tableHeaderPrefixLength is the length of the fixed part of the table header common to all dBase levels, holding
+// the length of the header as a whole.
+const tableHeaderPrefixLength = 32
+
+// Reader reads the records of a dbase table one at a time from an io.Reader, holding only the table header and the
+// current record in memory.
+//
+// Records are read with the Next/Record/Err pattern:
+//
+//	for reader.Next() {
+//		values := reader.Record()
+//	}
+//	if err := reader.Err(); err != nil {
+//		...
+//	}
+type Reader struct {
+	source io.Reader
+	table  *DbfTable // header of the table, followed by space for the current record at row 0
+	row    int
+	err    error
+}
+
+// NewReader creates a Reader, reading the table header from the supplied io.Reader, expecting the supplied encoding.
+// Records are left unread until Next is called.
+func NewReader(source io.Reader, fileEncoding string) (tableReader *Reader, newErr error) {
+	headerBytes, readErr := readHeader(source)
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	dt := new(DbfTable)
+	if encodingErr := dt.useFileEncoding(fileEncoding, headerBytes); encodingErr != nil {
+		return nil, encodingErr
+	}
+	if headerErr := unpackHeader(headerBytes, dt); headerErr != nil {
+		return nil, headerErr
+	}
+
+	dt.dataStore = append(headerBytes, make([]byte, dt.lengthOfEachRecord)...)
+	lockSchema(dt)
+
+	return &Reader{source: source, table: dt, row: -1}, nil
+}
+
+// readHeader reads the raw bytes of a table header, up to the first record, from the supplied io.Reader.
+func readHeader(source io.Reader) ([]byte, error) {
+	headerPrefix := make([]byte, tableHeaderPrefixLength)
+	if read, readErr := io.ReadFull(source, headerPrefix); readErr != nil {
+		return nil, headerReadError(read, tableHeaderPrefixLength, readErr)
+	}
+
+	headerLength := int(headerPrefix[8]) | int(headerPrefix[9])<<8
+	if headerLength < tableHeaderPrefixLength {
+		return nil, &CorruptHeaderError{Offset: 8, What: "header length", Expected: tableHeaderPrefixLength, Actual: headerLength}
+	}
+
+	headerBytes := make([]byte, headerLength)
+	copy(headerBytes, headerPrefix)
+	if read, readErr := io.ReadFull(source, headerBytes[tableHeaderPrefixLength:]); readErr != nil {
+		return nil, headerReadError(tableHeaderPrefixLength+read, headerLength, readErr)
+	}
+	return headerBytes, nil
+}
+
+// headerReadError describes a failure to read a table header of the expected length, having read the given number of
+// bytes of it.
+func headerReadError(read int, expected int, readErr error) error {
+	if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+		return &CorruptHeaderError{What: "content length", Expected: expected, Actual: read, Err: ErrTruncated}
+	}
+	return fmt.Errorf("reading table header: %w", readErr)
+}
+
+// NewReaderWithMemo creates a Reader as NewReader does, reading the content of memo fields from the raw byte array of
+// the table's companion memo file.
+func NewReaderWithMemo(source io.Reader, memoData []byte, fileEncoding string) (*Reader, error) {
+	tableReader, newErr := NewReader(source, fileEncoding)
+	if newErr != nil {
+		return nil, newErr
+	}
+
+	if memoErr := tableReader.table.attachMemo(memoData); memoErr != nil {
+		return nil, memoErr
+	}
+
+	return tableReader, nil
+}
+
+// Next reads the next record, returning true if there was one to read. It returns false once all records in the
+// table have been read, or reading fails, after which Err reports any failure.
+func (r *Reader) Next() bool {
+	if r.err != nil || r.row+1 >= int(r.table.numberOfRecords) {
+		return false
+	}
+
+	recordBytes := r.table.dataStore[r.table.numberOfBytesInHeader:]
+	if _, readErr := io.ReadFull(r.source, recordBytes); readErr != nil {
+		if errors.Is(readErr, io.EOF) {
+			readErr = io.ErrUnexpectedEOF
+		}
+		r.err = fmt.Errorf("reading record %d of %d: %w", r.row+1, r.table.numberOfRecords, readErr)
+		return false
+	}
+
+	r.row++
+	return true
+}
+
+// Err returns the first error encountered reading records, or nil if reading succeeded.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// Fields return the fields of the table as a slice
+func (r *Reader) Fields() []FieldDescriptor {
+	return r.table.Fields()
+}
+
+// FieldNames return the names of fields in the table as a slice
+func (r *Reader) FieldNames() []string {
+	return r.table.FieldNames()
+}
+
+// NumberOfRecords returns the number of records the table header records the table holding.
+func (r *Reader) NumberOfRecords() int {
+	return r.table.NumberOfRecords()
+}
+
+// Row returns the row number of the current record, or -1 before Next is first called.
+func (r *Reader) Row() int {
+	return r.row
+}
+
+// Record returns the field values of the current record as a string slice, as DbfTable.GetRowAsSlice does.
+func (r *Reader) Record() []string {
+	return r.table.GetRowAsSlice(0)
+}
+
+// FieldValueByName returns the value of the named field of the current record.
+func (r *Reader) FieldValueByName(fieldName string) (value string, err error) {
+	value, err = r.table.FieldValueByName(0, fieldName)
+	return value, r.atCurrentRow(err)
+}
+
+// SetStrictEncoding controls whether content that does not decode to text is reported, as
+// DbfTable.SetStrictEncoding does.
+func (r *Reader) SetStrictEncoding(on bool) {
+	r.table.SetStrictEncoding(on)
+}
+
+// atCurrentRow returns err with the row of any EncodingError it holds set to the row of the current record, in place
+// of the row the record is held at in the table.
+func (r *Reader) atCurrentRow(err error) error {
+	var encodingErr *EncodingError
+	if errors.As(err, &encodingErr) {
+		encodingErr.Row = r.row
+	}
+	return err
+}
+
+// IsDeleted returns whether the current record is marked as deleted.
+func (r *Reader) IsDeleted() bool {
+	isDeleted, _ := r.table.RowIsDeleted(0)
+	return isDeleted
+}
+
+// Unmarshal reads the current record into the struct v points to, as DbfTable.Unmarshal does.
+func (r *Reader) Unmarshal(v any) error {
+	return r.atCurrentRow(r.table.Unmarshal(0, v))
+}
@@ -0,0 +1,135 @@
+package godbf
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewReader_RecordsMatchLoadedTable(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	loadedTable, loadErr := NewFromFile(validTestFile, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+
+	file, openErr := os.Open(validTestFile)
+	g.Expect(openErr).To(BeNil())
+	defer file.Close()
+
+	readerUnderTest, newErr := NewReader(file, testEncoding)
+	g.Expect(newErr).To(BeNil())
+
+	g.Expect(readerUnderTest.FieldNames()).To(Equal(loadedTable.FieldNames()))
+	g.Expect(readerUnderTest.NumberOfRecords()).To(Equal(loadedTable.NumberOfRecords()))
+	g.Expect(readerUnderTest.Row()).To(Equal(-1))
+
+	rowsRead := 0
+	for readerUnderTest.Next() {
+		g.Expect(readerUnderTest.Row()).To(Equal(rowsRead))
+		g.Expect(readerUnderTest.Record()).To(Equal(loadedTable.GetRowAsSlice(rowsRead)))
+
+		expectedDeleted, _ := loadedTable.RowIsDeleted(rowsRead)
+		g.Expect(readerUnderTest.IsDeleted()).To(Equal(expectedDeleted))
+		rowsRead++
+	}
+
+	g.Expect(readerUnderTest.Err()).To(BeNil())
+	g.Expect(rowsRead).To(Equal(loadedTable.NumberOfRecords()))
+	g.Expect(readerUnderTest.Next()).To(BeFalse())
+}
+
+func TestNewReader_VisualFoxPro(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	readerUnderTest, newErr := NewReader(bytes.NewReader(visualFoxProTestBytes()), testEncoding)
+	g.Expect(newErr).To(BeNil())
+
+	var record struct {
+		Name   *string `dbf:"NAME"`
+		Amount int     `dbf:"AMOUNT"`
+	}
+
+	g.Expect(readerUnderTest.Next()).To(BeTrue())
+	g.Expect(readerUnderTest.FieldValueByName("NAME")).To(Equal("alice"))
+
+	g.Expect(readerUnderTest.Next()).To(BeTrue())
+	g.Expect(readerUnderTest.Unmarshal(&record)).To(Succeed())
+	g.Expect(record.Name).To(BeNil())
+	g.Expect(record.Amount).To(Equal(7))
+
+	g.Expect(readerUnderTest.Next()).To(BeFalse())
+	g.Expect(readerUnderTest.Err()).To(BeNil())
+}
+
+func TestNewReader_TruncatedRecords_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	data := visualFoxProTestBytes()
+	readerUnderTest, newErr := NewReader(bytes.NewReader(data[:len(data)-8]), testEncoding)
+	g.Expect(newErr).To(BeNil())
+
+	g.Expect(readerUnderTest.Next()).To(BeTrue())
+	g.Expect(readerUnderTest.Next()).To(BeFalse())
+	g.Expect(errors.Is(readerUnderTest.Err(), io.ErrUnexpectedEOF)).To(BeTrue())
+	t.Log(readerUnderTest.Err())
+}
+
+func TestNewReader_TruncatedHeader_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, prefixErr := NewReader(bytes.NewReader(make([]byte, 10)), testEncoding)
+	g.Expect(prefixErr).ToNot(BeNil())
+
+	data := visualFoxProTestBytes()
+	_, headerErr := NewReader(bytes.NewReader(data[:40]), testEncoding)
+	g.Expect(headerErr).ToNot(BeNil())
+	t.Log(headerErr)
+}
+
+func TestNewReaderWithMemo(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableToRead := New(testEncoding)
+	tableToRead.AddMemoField("NOTES")
+	recordIndex, _ := tableToRead.AddNewRecord()
+	tableToRead.SetFieldValueByName(recordIndex, "NOTES", "streamed memo")
+
+	readerUnderTest, newErr := NewReaderWithMemo(bytes.NewReader(tableToRead.dataStore), tableToRead.memo.bytes(), testEncoding)
+	g.Expect(newErr).To(BeNil())
+
+	g.Expect(readerUnderTest.Next()).To(BeTrue())
+	g.Expect(readerUnderTest.Record()).To(Equal([]string{"streamed memo"}))
+}
+
+func TestReader_SetStrictEncoding_ErrorsAtCurrentRow(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	table := New(testEncoding)
+	table.AddTextField("NAME", 5)
+	table.AddNewRecord()
+	table.AddNewRecord()
+	table.SetFieldValueByName(0, "NAME", "ok")
+	fieldBytes, _ := table.fieldBytes(1, 0)
+	copy(fieldBytes, []byte{0xFF})
+
+	var content bytes.Buffer
+	g.Expect(writeContent(table, &content)).To(Succeed())
+
+	readerUnderTest, newErr := NewReader(&content, testEncoding)
+	g.Expect(newErr).To(BeNil())
+	readerUnderTest.SetStrictEncoding(true)
+
+	g.Expect(readerUnderTest.Next()).To(BeTrue())
+	g.Expect(readerUnderTest.FieldValueByName("NAME")).To(Equal("ok"))
+
+	g.Expect(readerUnderTest.Next()).To(BeTrue())
+	_, valueErr := readerUnderTest.FieldValueByName("NAME")
+
+	var encodingErr *EncodingError
+	g.Expect(errors.As(valueErr, &encodingErr)).To(BeTrue())
+	g.Expect(encodingErr.Row).To(Equal(1))
+}
@@ -0,0 +1,206 @@
+package godbf
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Record gives named and typed access to the fields of a row of a DbfTable. It reads the row's current content from
+// the table whenever asked, so reflects changes made to the row after it was created.
+// Field names are matched as Unmarshal matches them: exactly where possible, and otherwise case-insensitively.
+type Record struct {
+	table *DbfTable
+	row   int
+}
+
+// Record returns a Record for the given row.
+// If the row does not exist, an error is returned.
+func (dt *DbfTable) Record(row int) (Record, error) {
+	if row < 0 || !dt.HasRecord(row) {
+		return Record{}, fmt.Errorf("row %d does not exist: %w", row, ErrRowOutOfBounds)
+	}
+	return Record{table: dt, row: row}, nil
+}
+
+// RowNumber returns the row number of the record within its table.
+func (r Record) RowNumber() int {
+	return r.row
+}
+
+// IsDeleted returns whether the record is marked as deleted.
+func (r Record) IsDeleted() bool {
+	isDeleted, _ := r.table.RowIsDeleted(r.row)
+	return isDeleted
+}
+
+// Get returns the value of the named field as the Go type best suited to the field's type:
+//   - string for Character fields, and text memos
+//   - int64 for Integer and Autoincrement fields, and Numeric fields without decimal places
+//   - float64 for Float, Currency and Double fields, and Numeric fields with decimal places
+//   - bool for Logical fields
+//   - time.Time for Date, DateTime and Timestamp fields
+//   - []byte for binary memos
+//
+// A field holding null, no value, or an uninitialised logical value returns nil.
+// If the field does not exist, or its content cannot be interpreted as its type, an error is returned.
+func (r Record) Get(fieldName string) (any, error) {
+	fieldIndex, found := r.table.fieldIndexMatching(fieldName)
+	if !found {
+		return nil, &FieldNotFoundError{FieldName: fieldName}
+	}
+	return r.value(fieldIndex)
+}
+
+func (r Record) value(fieldIndex int) (any, error) {
+	dt := r.table
+	field := dt.fields[fieldIndex]
+
+	if dt.storesInMemo(field) {
+		content, memoErr := dt.MemoFieldValue(r.row, fieldIndex)
+		if memoErr != nil || content == "" {
+			return nil, memoErr
+		}
+		return content, nil
+	}
+
+	if dt.isEmpty(r.row, fieldIndex) {
+		return nil, nil
+	}
+
+	switch field.fieldType {
+	case Character:
+		return dt.fieldValue(r.row, fieldIndex)
+	case Logical:
+		value, _, parseErr := parseLogical(dt.FieldValue(r.row, fieldIndex))
+		return value, parseErr
+	case Date, DateTime, Timestamp:
+		return dt.timeFieldValue(r.row, fieldIndex)
+	case Integer, Autoincrement:
+		return r.int64(fieldIndex)
+	case Numeric:
+		if field.decimalPlaces == 0 {
+			return parseWholeNumber(dt.FieldValue(r.row, fieldIndex))
+		}
+	}
+	return r.float64(fieldIndex)
+}
+
+// String returns the value of the named field as text, as DbfTable.FieldValueByName does.
+// If the field does not exist, an error is returned.
+func (r Record) String(fieldName string) (string, error) {
+	fieldIndex, found := r.table.fieldIndexMatching(fieldName)
+	if !found {
+		return "", &FieldNotFoundError{FieldName: fieldName}
+	}
+	return r.table.fieldValue(r.row, fieldIndex)
+}
+
+// Int64 returns the value of the named field as an integer. A field holding null or no value returns 0.
+// If the field does not exist, or does not hold a whole number, an error is returned.
+func (r Record) Int64(fieldName string) (int64, error) {
+	fieldIndex, found := r.table.fieldIndexMatching(fieldName)
+	if !found {
+		return 0, &FieldNotFoundError{FieldName: fieldName}
+	}
+
+	dt := r.table
+	switch {
+	case dt.isEmpty(r.row, fieldIndex):
+		return 0, nil
+	case dt.fields[fieldIndex].fieldType == Integer || dt.fields[fieldIndex].fieldType == Autoincrement:
+		return r.int64(fieldIndex)
+	default:
+		return parseWholeNumber(dt.FieldValue(r.row, fieldIndex))
+	}
+}
+
+// Float64 returns the value of the named field as a floating point number. A field holding null or no value
+// returns 0.
+// If the field does not exist, or does not hold a number, an error is returned.
+func (r Record) Float64(fieldName string) (float64, error) {
+	fieldIndex, found := r.table.fieldIndexMatching(fieldName)
+	if !found {
+		return 0, &FieldNotFoundError{FieldName: fieldName}
+	}
+	if r.table.isEmpty(r.row, fieldIndex) {
+		return 0, nil
+	}
+	return r.float64(fieldIndex)
+}
+
+// int64 returns the value of the Integer or Autoincrement field at the given index.
+func (r Record) int64(fieldIndex int) (int64, error) {
+	fieldBytes, bytesErr := r.table.fieldBytes(r.row, fieldIndex)
+	if bytesErr != nil {
+		return 0, bytesErr
+	}
+	return int64(r.table.decodeIntegerField(fieldBytes)), nil
+}
+
+func (r Record) float64(fieldIndex int) (float64, error) {
+	dt := r.table
+	field := dt.fields[fieldIndex]
+
+	if field.fieldType == Currency || (field.fieldType == dt.doubleType() && !dt.storesInMemo(field)) {
+		fieldBytes, bytesErr := dt.fieldBytes(r.row, fieldIndex)
+		if bytesErr != nil {
+			return 0, bytesErr
+		}
+		if field.fieldType == Currency {
+			return decodeCurrency(fieldBytes), nil
+		}
+		return decodeDoubleField(field, fieldBytes), nil
+	}
+
+	value := dt.FieldValue(r.row, fieldIndex)
+	floatValue, parseErr := strconv.ParseFloat(value, 64)
+	if parseErr != nil {
+		return 0, fmt.Errorf("value %q of %s field is not a number: %w", value, field.fieldType.name(), parseErr)
+	}
+	return floatValue, nil
+}
+
+// Bool returns the value of the named field as a logical value. A field holding null, no value, or an uninitialised
+// logical value returns false.
+// If the field does not exist, or does not hold a logical value, an error is returned.
+func (r Record) Bool(fieldName string) (bool, error) {
+	fieldIndex, found := r.table.fieldIndexMatching(fieldName)
+	if !found {
+		return false, &FieldNotFoundError{FieldName: fieldName}
+	}
+	if r.table.isEmpty(r.row, fieldIndex) {
+		return false, nil
+	}
+	value, _, parseErr := parseLogical(r.table.FieldValue(r.row, fieldIndex))
+	return value, parseErr
+}
+
+// Time returns the value of the named field as a time.Time, interpreting the text of fields other than Date,
+// DateTime and Timestamp fields as a date (YYYYMMDD) or date and time (YYYYMMDDhhmmss). A field holding null or no
+// value returns the zero time.Time.
+// If the field does not exist, or does not hold a date, an error is returned.
+func (r Record) Time(fieldName string) (time.Time, error) {
+	fieldIndex, found := r.table.fieldIndexMatching(fieldName)
+	if !found {
+		return time.Time{}, &FieldNotFoundError{FieldName: fieldName}
+	}
+	if r.table.isEmpty(r.row, fieldIndex) {
+		return time.Time{}, nil
+	}
+	return r.table.timeFieldValue(r.row, fieldIndex)
+}
+
+// ToMap returns the values of all fields of the record, keyed by field name, as Get returns them. A field whose
+// content cannot be interpreted as its type is given its text, as String returns it.
+func (r Record) ToMap() map[string]any {
+	values := make(map[string]any, len(r.table.fields))
+	for fieldIndex, field := range r.table.fields {
+		value, valueErr := r.value(fieldIndex)
+		if valueErr != nil {
+			value = r.table.FieldValue(r.row, fieldIndex)
+		}
+		values[field.name] = value
+	}
+	return values
+}
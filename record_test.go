@@ -0,0 +1,129 @@
+package godbf
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func newRecordTestTable(g *GomegaWithT) *DbfTable {
+	tableUnderTest := New(testEncoding)
+	g.Expect(tableUnderTest.AddTextField("NAME", 10)).To(Succeed())
+	g.Expect(tableUnderTest.AddNumberField("COUNT", 6, 0)).To(Succeed())
+	g.Expect(tableUnderTest.AddNumberField("AMOUNT", 8, 2)).To(Succeed())
+	g.Expect(tableUnderTest.AddBooleanField("ACTIVE")).To(Succeed())
+	g.Expect(tableUnderTest.AddDateField("BORN")).To(Succeed())
+	g.Expect(tableUnderTest.AddMemoField("NOTES")).To(Succeed())
+
+	row, _ := tableUnderTest.AddNewRecord()
+	tableUnderTest.SetFieldValueByName(row, "NAME", "alice")
+	tableUnderTest.SetFieldValueByName(row, "COUNT", "42")
+	tableUnderTest.SetFieldValueByName(row, "AMOUNT", "12.50")
+	tableUnderTest.SetFieldValueByName(row, "ACTIVE", "T")
+	tableUnderTest.SetFieldValueByName(row, "BORN", "19800229")
+	tableUnderTest.SetFieldValueByName(row, "NOTES", "some notes")
+
+	tableUnderTest.AddNewRecord()
+	return tableUnderTest
+}
+
+func TestDbfTable_Record_RowOutOfRange_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newRecordTestTable(g)
+
+	_, recordErr := tableUnderTest.Record(2)
+	g.Expect(recordErr).ToNot(BeNil())
+	_, recordErr = tableUnderTest.Record(-1)
+	g.Expect(recordErr).ToNot(BeNil())
+}
+
+func TestRecord_TypedGetters(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newRecordTestTable(g)
+	recordUnderTest, _ := tableUnderTest.Record(0)
+
+	g.Expect(recordUnderTest.RowNumber()).To(Equal(0))
+	g.Expect(recordUnderTest.String("NAME")).To(Equal("alice"))
+	g.Expect(recordUnderTest.Int64("COUNT")).To(Equal(int64(42)))
+	g.Expect(recordUnderTest.Float64("AMOUNT")).To(Equal(12.5))
+	g.Expect(recordUnderTest.Bool("ACTIVE")).To(BeTrue())
+	g.Expect(recordUnderTest.Time("born")).To(Equal(time.Date(1980, 2, 29, 0, 0, 0, 0, time.UTC)))
+
+	_, intErr := recordUnderTest.Int64("NAME")
+	g.Expect(intErr).ToNot(BeNil())
+	_, missingErr := recordUnderTest.String("MISSING")
+	g.Expect(missingErr).ToNot(BeNil())
+}
+
+func TestRecord_Get_TypeAware(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newRecordTestTable(g)
+	recordUnderTest, _ := tableUnderTest.Record(0)
+
+	g.Expect(recordUnderTest.Get("NAME")).To(Equal("alice"))
+	g.Expect(recordUnderTest.Get("COUNT")).To(Equal(int64(42)))
+	g.Expect(recordUnderTest.Get("AMOUNT")).To(Equal(12.5))
+	g.Expect(recordUnderTest.Get("ACTIVE")).To(Equal(true))
+	g.Expect(recordUnderTest.Get("BORN")).To(Equal(time.Date(1980, 2, 29, 0, 0, 0, 0, time.UTC)))
+	g.Expect(recordUnderTest.Get("NOTES")).To(Equal("some notes"))
+}
+
+func TestRecord_EmptyFields_ZeroValues(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newRecordTestTable(g)
+	recordUnderTest, _ := tableUnderTest.Record(1)
+
+	g.Expect(recordUnderTest.Get("COUNT")).To(BeNil())
+	g.Expect(recordUnderTest.Get("NOTES")).To(BeNil())
+	g.Expect(recordUnderTest.Int64("COUNT")).To(BeZero())
+	g.Expect(recordUnderTest.Float64("AMOUNT")).To(BeZero())
+	g.Expect(recordUnderTest.Bool("ACTIVE")).To(BeFalse())
+	g.Expect(recordUnderTest.Time("BORN")).To(BeZero())
+}
+
+func TestRecord_ToMap(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newRecordTestTable(g)
+	recordUnderTest, _ := tableUnderTest.Record(0)
+
+	g.Expect(recordUnderTest.ToMap()).To(Equal(map[string]any{
+		"NAME":   "alice",
+		"COUNT":  int64(42),
+		"AMOUNT": 12.5,
+		"ACTIVE": true,
+		"BORN":   time.Date(1980, 2, 29, 0, 0, 0, 0, time.UTC),
+		"NOTES":  "some notes",
+	}))
+}
+
+func TestRecord_IsDeleted_ReflectsTable(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newRecordTestTable(g)
+	recordUnderTest, _ := tableUnderTest.Record(1)
+	g.Expect(recordUnderTest.IsDeleted()).To(BeFalse())
+
+	tableUnderTest.SetRowIsDeleted(1)
+	g.Expect(recordUnderTest.IsDeleted()).To(BeTrue())
+}
+
+func TestRecord_BinaryFields_TypeAware(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+	tableUnderTest.AddIntegerField("ID")
+	tableUnderTest.AddCurrencyField("PRICE")
+	row, _ := tableUnderTest.AddNewRecord()
+	tableUnderTest.SetIntegerFieldValueByName(row, "ID", -7)
+	tableUnderTest.SetCurrencyFieldValueByName(row, "PRICE", 3.25)
+
+	recordUnderTest, _ := tableUnderTest.Record(row)
+	g.Expect(recordUnderTest.Get("ID")).To(Equal(int64(-7)))
+	g.Expect(recordUnderTest.Get("PRICE")).To(Equal(3.25))
+}
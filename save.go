@@ -0,0 +1,193 @@
+package godbf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+)
+
+const (
+	tableBackupFileExtension = ".bak"
+	memoBackupFileExtension  = ".tbk"
+)
+
+// SaveOption configures how SaveToFile saves a table.
+type SaveOption func(*saveSettings)
+
+type saveSettings struct {
+	keepBackup bool
+}
+
+// WithBackup keeps the previously saved version of each file replaced by SaveToFile, renaming the table file's
+// extension to .bak, and the memo file's extension to .tbk, as dBase does. Any earlier backups are replaced.
+func WithBackup() SaveOption {
+	return func(settings *saveSettings) {
+		settings.keepBackup = true
+	}
+}
+
+// savedFile is a file saved as one of a set, such as a table file and its companion memo file.
+type savedFile struct {
+	name       string
+	backupName string
+	write      func(f io.Writer) error
+
+	tempName     string // name of the temporary file the content is written to, before it replaces the named file
+	previousName string // name the previously saved version is kept under while the set is replaced, or ""
+	hadPrevious  bool   // whether there was a previously saved version to keep
+}
+
+// saveFiles writes the content of each file to a synced temporary file alongside it, and only once all have been
+// written, replaces the files with them, in the order given. If any content cannot be written, no file is replaced.
+// The previously saved version of each file but the last is kept until all are replaced, so that should replacing a
+// later file fail, the files already replaced are rolled back to their previous versions.
+func saveFiles(files []savedFile, options ...SaveOption) error {
+	var settings saveSettings
+	for _, option := range options {
+		option(&settings)
+	}
+
+	for i := range files {
+		if writeErr := files[i].writeTemp(); writeErr != nil {
+			removeTemps(files)
+			return writeErr
+		}
+	}
+
+	for i := range files {
+		switch {
+		case settings.keepBackup:
+			files[i].previousName = files[i].backupName
+		case i < len(files)-1:
+			files[i].previousName = fmt.Sprintf("%s.%08x.old", files[i].name, rand.Uint32())
+		default:
+			continue
+		}
+		if keepErr := files[i].keepPrevious(); keepErr != nil {
+			removeTemps(files)
+			if !settings.keepBackup {
+				removePrevious(files[:i])
+			}
+			return keepErr
+		}
+	}
+
+	for i, f := range files {
+		if renameErr := fsWrapper.Rename(f.tempName, f.name); renameErr != nil {
+			removeTemps(files[i:])
+			rollBack(files[:i])
+			return fmt.Errorf("replacing %q: %w", f.name, renameErr)
+		}
+	}
+
+	if !settings.keepBackup {
+		removePrevious(files)
+	}
+	syncDirectories(files)
+	return nil
+}
+
+// writeTemp writes the file's content to a new temporary file in the same directory, and syncs it to disk.
+func (sf *savedFile) writeTemp() (writeErr error) {
+	sf.tempName = fmt.Sprintf("%s.%08x.tmp", sf.name, rand.Uint32())
+
+	f, createErr := fsWrapper.Create(sf.tempName)
+	if createErr != nil {
+		sf.tempName = ""
+		return createErr
+	}
+
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil && writeErr == nil {
+			writeErr = closeErr
+		}
+	}()
+
+	// keep the permissions of the file being replaced
+	if info, statErr := fsWrapper.Stat(sf.name); statErr == nil {
+		if chmodErr := f.Chmod(info.Mode().Perm()); chmodErr != nil {
+			return chmodErr
+		}
+	}
+
+	if contentErr := sf.write(f); contentErr != nil {
+		return contentErr
+	}
+	return f.Sync()
+}
+
+// keepPrevious keeps the file's currently saved version, if there is one, under its previous name, replacing any file
+// already of that name. The saved version is linked to where possible, so that it stays in place until replaced.
+func (sf *savedFile) keepPrevious() error {
+	if _, statErr := fsWrapper.Stat(sf.name); errors.Is(statErr, os.ErrNotExist) {
+		return nil
+	}
+
+	if removeErr := fsWrapper.Remove(sf.previousName); removeErr != nil && !errors.Is(removeErr, os.ErrNotExist) {
+		return fmt.Errorf("removing %q: %w", sf.previousName, removeErr)
+	}
+
+	sf.hadPrevious = true
+	if linkErr := fsWrapper.Link(sf.name, sf.previousName); linkErr == nil {
+		return nil
+	}
+
+	content, readErr := readFile(sf.name)
+	if readErr != nil {
+		sf.hadPrevious = false
+		return fmt.Errorf("keeping previous version of %q: %w", sf.name, readErr)
+	}
+	return saveFiles([]savedFile{{
+		name:  sf.previousName,
+		write: func(f io.Writer) error { _, writeErr := f.Write(content); return writeErr },
+	}})
+}
+
+// rollBack returns the replaced files to their previously saved versions, removing those that had none.
+func rollBack(files []savedFile) {
+	for _, f := range files {
+		switch {
+		case f.hadPrevious:
+			fsWrapper.Rename(f.previousName, f.name)
+		case f.previousName != "":
+			fsWrapper.Remove(f.name)
+		}
+	}
+}
+
+func removePrevious(files []savedFile) {
+	for _, f := range files {
+		if f.hadPrevious {
+			fsWrapper.Remove(f.previousName)
+		}
+	}
+}
+
+func removeTemps(files []savedFile) {
+	for _, f := range files {
+		if f.tempName != "" {
+			fsWrapper.Remove(f.tempName)
+		}
+	}
+}
+
+// syncDirectories syncs the directories holding the files, so that their replacement survives a crash. Not all
+// platforms support syncing directories, so failures are ignored.
+func syncDirectories(files []savedFile) {
+	synced := make(map[string]bool)
+	for _, f := range files {
+		directory := filepath.Dir(f.name)
+		if synced[directory] {
+			continue
+		}
+		synced[directory] = true
+
+		if d, openErr := os.Open(directory); openErr == nil {
+			d.Sync()
+			d.Close()
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package godbf
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func newSaveTestTable(g *GomegaWithT, note string) *DbfTable {
+	tableUnderTest := New(testEncoding)
+	g.Expect(tableUnderTest.AddTextField("NAME", 10)).To(Succeed())
+	g.Expect(tableUnderTest.AddMemoField("NOTES")).To(Succeed())
+
+	row, _ := tableUnderTest.AddNewRecord()
+	g.Expect(tableUnderTest.SetFieldValueByName(row, "NAME", note)).To(Succeed())
+	g.Expect(tableUnderTest.SetFieldValueByName(row, "NOTES", note+" notes")).To(Succeed())
+	return tableUnderTest
+}
+
+func expectNoTempFiles(g *GomegaWithT, directory string) {
+	entries, readErr := os.ReadDir(directory)
+	g.Expect(readErr).To(BeNil())
+	for _, entry := range entries {
+		g.Expect(entry.Name()).ToNot(HaveSuffix(".tmp"))
+		g.Expect(entry.Name()).ToNot(HaveSuffix(".old"))
+	}
+}
+
+func TestSaveToFile_WithMemo_BothFilesSaved(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	directory := t.TempDir()
+	tempFilename := filepath.Join(directory, "saved.dbf")
+
+	g.Expect(SaveToFile(newSaveTestTable(g, "first"), tempFilename)).To(Succeed())
+
+	savedTable, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(savedTable.FieldValueByName(0, "NOTES")).To(Equal("first notes"))
+	expectNoTempFiles(g, directory)
+}
+
+func TestSaveToFile_WithBackup_PreviousVersionKept(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	directory := t.TempDir()
+	tempFilename := filepath.Join(directory, "saved.dbf")
+
+	g.Expect(SaveToFile(newSaveTestTable(g, "first"), tempFilename, WithBackup())).To(Succeed())
+	g.Expect(filepath.Join(directory, "saved.bak")).ToNot(BeAnExistingFile())
+
+	g.Expect(SaveToFile(newSaveTestTable(g, "second"), tempFilename, WithBackup())).To(Succeed())
+
+	savedTable, _ := NewFromFile(tempFilename, testEncoding)
+	g.Expect(savedTable.FieldValueByName(0, "NOTES")).To(Equal("second notes"))
+
+	backupTable, _ := NewFromFile(filepath.Join(directory, "saved.bak"), testEncoding)
+	g.Expect(backupTable.FieldValueByName(0, "NAME")).To(Equal("first"))
+
+	backupMemo, readErr := os.ReadFile(filepath.Join(directory, "saved.tbk"))
+	g.Expect(readErr).To(BeNil())
+	g.Expect(string(backupMemo)).To(ContainSubstring("first notes"))
+	g.Expect(string(backupMemo)).ToNot(ContainSubstring("second notes"))
+	expectNoTempFiles(g, directory)
+}
+
+func TestSaveToFile_TableCreateErrors_PreviousFilesIntact(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	directory := t.TempDir()
+	tempFilename := filepath.Join(directory, "saved.dbf")
+	g.Expect(SaveToFile(newSaveTestTable(g, "first"), tempFilename)).To(Succeed())
+
+	fsWrapper = tableCreateErrorFileSystem{}
+	defer func() { fsWrapper = osFileSystem{} }()
+	saveErr := SaveToFile(newSaveTestTable(g, "second"), tempFilename)
+	fsWrapper = osFileSystem{}
+
+	g.Expect(saveErr).ToNot(BeNil())
+
+	savedTable, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(savedTable.FieldValueByName(0, "NOTES")).To(Equal("first notes"))
+	expectNoTempFiles(g, directory)
+}
+
+// tableCreateErrorFileSystem fails to create table files, after memo files have been created.
+type tableCreateErrorFileSystem struct {
+	osFileSystem
+}
+
+func (tableCreateErrorFileSystem) Create(name string) (*os.File, error) {
+	if strings.Contains(name, ".dbf") {
+		return nil, errors.New("Create error")
+	}
+	return os.Create(name)
+}
+
+func TestSaveToFile_RenameErrors_PreviousFileIntact(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	directory := t.TempDir()
+	tempFilename := filepath.Join(directory, "saved.dbf")
+	rawFileBytes, _ := os.ReadFile(validTestFile)
+	g.Expect(os.WriteFile(tempFilename, rawFileBytes, 0644)).To(Succeed())
+
+	tableFromBytes, _ := NewFromByteArray(rawFileBytes, testEncoding)
+	tableFromBytes.SetFieldValueByName(0, "TESTTEXT", "changed")
+
+	fsWrapper = renameErrorFileSystem{}
+	defer func() { fsWrapper = osFileSystem{} }()
+	saveErr := SaveToFile(tableFromBytes, tempFilename)
+	fsWrapper = osFileSystem{}
+
+	g.Expect(saveErr).ToNot(BeNil())
+
+	savedBytes, _ := os.ReadFile(tempFilename)
+	originalBytes, _ := os.ReadFile(validTestFile)
+	g.Expect(savedBytes).To(Equal(originalBytes))
+	expectNoTempFiles(g, directory)
+}
+
+type renameErrorFileSystem struct {
+	osFileSystem
+}
+
+func (renameErrorFileSystem) Rename(oldName string, newName string) error {
+	return errors.New("Rename error")
+}
+
+func TestSaveToFile_TableRenameErrors_MemoFileRolledBack(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	directory := t.TempDir()
+	tempFilename := filepath.Join(directory, "saved.dbf")
+	g.Expect(SaveToFile(newSaveTestTable(g, "first"), tempFilename)).To(Succeed())
+	originalMemo, readErr := os.ReadFile(filepath.Join(directory, "saved.dbt"))
+	g.Expect(readErr).To(BeNil())
+
+	packedTable := newSaveTestTable(g, "second")
+	_, packErr := packedTable.Pack()
+	g.Expect(packErr).To(BeNil())
+
+	fsWrapper = tableRenameErrorFileSystem{}
+	defer func() { fsWrapper = osFileSystem{} }()
+	saveErr := SaveToFile(packedTable, tempFilename)
+	fsWrapper = osFileSystem{}
+
+	g.Expect(saveErr).ToNot(BeNil())
+
+	savedMemo, _ := os.ReadFile(filepath.Join(directory, "saved.dbt"))
+	g.Expect(savedMemo).To(Equal(originalMemo))
+
+	savedTable, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(savedTable.FieldValueByName(0, "NOTES")).To(Equal("first notes"))
+	expectNoTempFiles(g, directory)
+}
+
+func TestSaveToFile_WithMemo_NoPreviousVersionsLeft(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	directory := t.TempDir()
+	tempFilename := filepath.Join(directory, "saved.dbf")
+	g.Expect(SaveToFile(newSaveTestTable(g, "first"), tempFilename)).To(Succeed())
+	g.Expect(SaveToFile(newSaveTestTable(g, "second"), tempFilename)).To(Succeed())
+
+	entries, _ := os.ReadDir(directory)
+	g.Expect(entries).To(HaveLen(2))
+}
+
+// tableRenameErrorFileSystem fails to replace table files, after memo files have been replaced.
+type tableRenameErrorFileSystem struct {
+	osFileSystem
+}
+
+func (tableRenameErrorFileSystem) Rename(oldName string, newName string) error {
+	if strings.HasSuffix(newName, ".dbf") {
+		return errors.New("Rename error")
+	}
+	return os.Rename(oldName, newName)
+}
@@ -0,0 +1,178 @@
+package godbf
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// A `dbf` struct tag may follow the table field name with the field's type, length and decimal places, such as
+// `dbf:"NAME,C,40"` or `dbf:"AMOUNT,N,12,2"`. The length and decimal places are ignored for fixed-length types.
+// Fields with no type in their tag are given a type suited to the struct field's Go type.
+
+const (
+	defaultCharacterFieldLength = 254
+	defaultFloatFieldLength     = 20
+	defaultFloatDecimalPlaces   = 6
+)
+
+// NewFromStruct creates a new dbase table from scratch for the given character encoding, with a field for each
+// exported field of the struct (or pointer to a struct) sample, as AddFieldsFromStruct describes.
+func NewFromStruct(encoding string, sample any) (table *DbfTable, err error) {
+	dt := New(encoding)
+	if addErr := dt.AddFieldsFromStruct(sample); addErr != nil {
+		return nil, addErr
+	}
+	return dt, nil
+}
+
+// AddFieldsFromStruct adds a field to the table for each exported field of the struct (or pointer to a struct)
+// sample, in struct field order, taking the name, type, length and decimal places of each field from its `dbf` tag.
+// Untagged struct fields, and tags naming no type, take their field name from the struct field's name, and their
+// type from its Go type: Character for strings, Numeric for integers and floats, Logical for bools and Date for
+// time.Time. Struct fields tagged "-" are skipped.
+// If sample is not a struct, a tag is invalid, or a field cannot be added to the table, an error is returned.
+func (dt *DbfTable) AddFieldsFromStruct(sample any) error {
+	structType := reflect.TypeOf(sample)
+	for structType != nil && structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+	if structType == nil || structType.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot derive table fields from %T; expected a struct or pointer to a struct", sample)
+	}
+
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		tag, tagged := structField.Tag.Lookup(dbfTagName)
+		if tag == "-" {
+			continue
+		}
+
+		name, options := parseDbfTag(tag)
+		if !tagged || name == "" {
+			name = structField.Name
+		}
+
+		if addErr := dt.addFieldFromStruct(name, options, structField.Type); addErr != nil {
+			return fmt.Errorf("struct field %s: %w", structField.Name, addErr)
+		}
+	}
+	return nil
+}
+
+// addFieldFromStruct adds a field with the given name, using the type, length and decimal places tag options, or
+// defaults suited to goType if the options name no type.
+func (dt *DbfTable) addFieldFromStruct(name string, options []string, goType reflect.Type) error {
+	if len(options) == 0 || strings.TrimSpace(options[0]) == "" {
+		fieldType, length, decimalPlaces, typeErr := defaultFieldTypeOf(goType)
+		if typeErr != nil {
+			return typeErr
+		}
+		return dt.addFieldOfType(name, fieldType, length, decimalPlaces)
+	}
+
+	typeOption := strings.TrimSpace(options[0])
+	if len(typeOption) != 1 {
+		return fmt.Errorf("field type %q in dbf tag is not a single character", typeOption)
+	}
+	fieldType := DbaseDataType(strings.ToUpper(typeOption)[0])
+
+	var length, decimalPlaces byte
+	if len(options) > 1 {
+		parsedLength, parseErr := strconv.ParseUint(strings.TrimSpace(options[1]), 10, 8)
+		if parseErr != nil {
+			return fmt.Errorf("field length %q in dbf tag is not a number from 0 to 255", options[1])
+		}
+		length = byte(parsedLength)
+	}
+	if len(options) > 2 {
+		parsedDecimalPlaces, parseErr := strconv.ParseUint(strings.TrimSpace(options[2]), 10, 8)
+		if parseErr != nil {
+			return fmt.Errorf("decimal places %q in dbf tag is not a number from 0 to 255", options[2])
+		}
+		decimalPlaces = byte(parsedDecimalPlaces)
+	}
+
+	return dt.addFieldOfType(name, fieldType, length, decimalPlaces)
+}
+
+// addFieldOfType adds a field of the given type through the Add*Field method for that type, defaulting the length of
+// variable-length fields given a length of 0.
+func (dt *DbfTable) addFieldOfType(name string, fieldType DbaseDataType, length byte, decimalPlaces byte) error {
+	switch fieldType {
+	case Character:
+		if length == 0 {
+			length = defaultCharacterFieldLength
+		}
+		return dt.AddTextField(name, length)
+	case Numeric, Float:
+		if length == 0 {
+			length = defaultFloatFieldLength
+		}
+		if decimalPlaces >= length {
+			return fmt.Errorf("decimal places %d do not fit in a field of length %d", decimalPlaces, length)
+		}
+		if fieldType == Float {
+			return dt.AddFloatField(name, length, decimalPlaces)
+		}
+		return dt.AddNumberField(name, length, decimalPlaces)
+	case Logical:
+		return dt.AddBooleanField(name)
+	case Date:
+		return dt.AddDateField(name)
+	case Memo:
+		return dt.AddMemoField(name)
+	case General:
+		return dt.AddGeneralField(name)
+	case Picture:
+		return dt.AddPictureField(name)
+	case Integer:
+		return dt.AddIntegerField(name)
+	case Currency:
+		return dt.AddCurrencyField(name)
+	case Double, Level7Double:
+		return dt.AddDoubleField(name, decimalPlaces)
+	case DateTime, Timestamp:
+		return dt.AddDateTimeField(name)
+	case Autoincrement:
+		return dt.AddAutoincrementField(name)
+	default:
+		return fmt.Errorf("field type %s is not supported", fieldType.name())
+	}
+}
+
+// defaultFieldTypeOf returns the field type, length and decimal places suited to storing values of the given Go type.
+func defaultFieldTypeOf(goType reflect.Type) (fieldType DbaseDataType, length byte, decimalPlaces byte, err error) {
+	if goType.Implements(fieldMarshalerType) || reflect.PointerTo(goType).Implements(fieldMarshalerType) {
+		return Character, defaultCharacterFieldLength, 0, nil
+	}
+
+	for goType.Kind() == reflect.Pointer {
+		goType = goType.Elem()
+	}
+
+	if goType == timeType {
+		return Date, Date.fixedFieldLength(), 0, nil
+	}
+
+	switch goType.Kind() {
+	case reflect.String:
+		return Character, defaultCharacterFieldLength, 0, nil
+	case reflect.Bool:
+		return Logical, Logical.fixedFieldLength(), 0, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// room for the digits of the type's minimum value, and its sign
+		return Numeric, byte(len(strconv.FormatInt(-1<<(goType.Bits()-1), 10))), 0, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Numeric, byte(len(strconv.FormatUint(1<<goType.Bits()-1, 10))), 0, nil
+	case reflect.Float32, reflect.Float64:
+		return Numeric, defaultFloatFieldLength, defaultFloatDecimalPlaces, nil
+	default:
+		return 0, 0, 0, fmt.Errorf("no default field type for Go type %s; add a field type to its dbf tag", goType)
+	}
+}
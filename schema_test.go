@@ -0,0 +1,134 @@
+package godbf
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewFromStruct_TaggedFields(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type tagged struct {
+		Name   string    `dbf:"NAME,C,40"`
+		Amount float64   `dbf:"AMOUNT,N,12,2"`
+		Rate   float64   `dbf:"RATE,f,8,3"`
+		Notes  string    `dbf:"NOTES,M"`
+		Born   time.Time `dbf:"BORN,D"`
+	}
+
+	tableUnderTest, newErr := NewFromStruct(testEncoding, tagged{})
+	g.Expect(newErr).To(BeNil())
+
+	fields := tableUnderTest.Fields()
+	g.Expect(tableUnderTest.FieldNames()).To(Equal([]string{"NAME", "AMOUNT", "RATE", "NOTES", "BORN"}))
+	g.Expect(fields[0].FieldType()).To(Equal(Character))
+	g.Expect(fields[0].Length()).To(BeNumerically("==", 40))
+	g.Expect(fields[1].FieldType()).To(Equal(Numeric))
+	g.Expect(fields[1].Length()).To(BeNumerically("==", 12))
+	g.Expect(fields[1].DecimalPlaces()).To(BeNumerically("==", 2))
+	g.Expect(fields[2].FieldType()).To(Equal(Float))
+	g.Expect(fields[2].DecimalPlaces()).To(BeNumerically("==", 3))
+	g.Expect(fields[3].FieldType()).To(Equal(Memo))
+	g.Expect(fields[4].FieldType()).To(Equal(Date))
+	g.Expect(tableUnderTest.memo).ToNot(BeNil())
+}
+
+func TestNewFromStruct_UntaggedDefaults(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type untagged struct {
+		Description string
+		Count       int32
+		Small       uint8
+		Ratio       float32
+		Active      *bool
+		Updated     time.Time
+		Name        upperCaseName
+		Skipped     string `dbf:"-"`
+		internal    string
+	}
+
+	tableUnderTest, newErr := NewFromStruct(testEncoding, &untagged{})
+	g.Expect(newErr).To(BeNil())
+
+	fields := tableUnderTest.Fields()
+	g.Expect(tableUnderTest.FieldNames()).To(Equal([]string{"Descriptio", "Count", "Small", "Ratio", "Active", "Updated", "Name"}))
+	g.Expect(fields[0].FieldType()).To(Equal(Character))
+	g.Expect(fields[0].Length()).To(BeNumerically("==", defaultCharacterFieldLength))
+	g.Expect(fields[1].FieldType()).To(Equal(Numeric))
+	g.Expect(fields[1].Length()).To(BeNumerically("==", len("-2147483648")))
+	g.Expect(fields[2].Length()).To(BeNumerically("==", len("255")))
+	g.Expect(fields[3].DecimalPlaces()).To(BeNumerically("==", defaultFloatDecimalPlaces))
+	g.Expect(fields[4].FieldType()).To(Equal(Logical))
+	g.Expect(fields[5].FieldType()).To(Equal(Date))
+	g.Expect(fields[6].FieldType()).To(Equal(Character))
+
+	recordIndex, _ := tableUnderTest.MarshalNewRecord(untagged{Description: "truncated name", Count: -7, Name: "x"})
+	var actualRecord untagged
+	g.Expect(tableUnderTest.Unmarshal(recordIndex, &actualRecord)).To(Succeed())
+	g.Expect(actualRecord.Description).To(Equal("truncated name"))
+	g.Expect(actualRecord.Count).To(BeNumerically("==", -7))
+	g.Expect(actualRecord.Active).To(BeNil())
+}
+
+func TestNewFromStruct_DuplicateName_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type duplicated struct {
+		First  string `dbf:"NAME"`
+		Second string `dbf:"NAME,C,10"`
+	}
+
+	_, newErr := NewFromStruct(testEncoding, duplicated{})
+	g.Expect(newErr).ToNot(BeNil())
+	t.Log(newErr)
+}
+
+func TestNewFromStruct_InvalidTags_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, notStructErr := NewFromStruct(testEncoding, 42)
+	g.Expect(notStructErr).ToNot(BeNil())
+
+	_, badTypeErr := NewFromStruct(testEncoding, struct {
+		Name string `dbf:"NAME,Q"`
+	}{})
+	g.Expect(badTypeErr).ToNot(BeNil())
+	t.Log(badTypeErr)
+
+	_, badLengthErr := NewFromStruct(testEncoding, struct {
+		Name string `dbf:"NAME,C,300"`
+	}{})
+	g.Expect(badLengthErr).ToNot(BeNil())
+
+	_, badDecimalsErr := NewFromStruct(testEncoding, struct {
+		Amount float64 `dbf:"AMOUNT,N,4,4"`
+	}{})
+	g.Expect(badDecimalsErr).ToNot(BeNil())
+
+	_, noDefaultErr := NewFromStruct(testEncoding, struct {
+		Tags []string
+	}{})
+	g.Expect(noDefaultErr).ToNot(BeNil())
+	t.Log(noDefaultErr)
+}
+
+func TestDbfTable_AddFieldsFromStruct_Dbase7(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type level7 struct {
+		ID      int32     `dbf:"ID,+"`
+		Stamp   time.Time `dbf:"STAMP,@"`
+		Balance float64   `dbf:"BALANCE,O"`
+	}
+
+	tableUnderTest := NewDbase7(testEncoding)
+	g.Expect(tableUnderTest.AddFieldsFromStruct(level7{})).To(Succeed())
+
+	fields := tableUnderTest.Fields()
+	g.Expect(fields[0].FieldType()).To(Equal(Autoincrement))
+	g.Expect(fields[1].FieldType()).To(Equal(Timestamp))
+	g.Expect(fields[2].FieldType()).To(Equal(Level7Double))
+}
@@ -0,0 +1,215 @@
+package godbf
+
+import (
+	"bytes"
+	"container/list"
+	"fmt"
+)
+
+// recordStorage holds the records of a table apart from its in-memory data store, such as in the file the table was
+// opened from.
+type recordStorage interface {
+	// record returns the content of the given row. Changes made to the content are kept by the storage.
+	record(row int) ([]byte, error)
+	// appendRecord adds a record with the given content after the last record.
+	appendRecord(content []byte) error
+	// flush persists the table header, and any changes made to records.
+	flush(header []byte) error
+	// close flushes the storage, and releases the resources it holds.
+	close(header []byte) error
+}
+
+// fileRecordStorage reads the records of a table file lazily, as they are asked for, a page of consecutive records at
+// a time. The most recently used pages are cached, and changed pages are written back to the file in place when
+// evicted from the cache, or flushed.
+type fileRecordStorage struct {
+	file            file
+	readOnly        bool
+	headerLength    int64
+	recordLength    int
+	numberOfRecords int
+	savedHeader     []byte
+
+	cache *pageCache
+
+	err     error // first error encountered reading or writing back records, reported on flush
+	readErr error // first error reading records since the last flush, while which no records are written back
+}
+
+// recordPage is a run of consecutive records read from a table file, along with their content as last read or
+// written, to detect changes with.
+type recordPage struct {
+	index int
+	data  []byte
+	saved []byte
+}
+
+// pageCache is a least-recently-used cache of record pages.
+type pageCache struct {
+	capacity       int
+	recordsPerPage int
+	pages          map[int]*list.Element
+	recency        *list.List // of *recordPage, most recently used first
+}
+
+func newPageCache(capacity int, recordsPerPage int) *pageCache {
+	return &pageCache{
+		capacity:       max(capacity, 1),
+		recordsPerPage: max(recordsPerPage, 1),
+		pages:          make(map[int]*list.Element),
+		recency:        list.New(),
+	}
+}
+
+func newFileRecordStorage(f file, header []byte, recordLength int, numberOfRecords int, readOnly bool, cache *pageCache) *fileRecordStorage {
+	return &fileRecordStorage{
+		file:            f,
+		readOnly:        readOnly,
+		headerLength:    int64(len(header)),
+		recordLength:    recordLength,
+		numberOfRecords: numberOfRecords,
+		savedHeader:     append([]byte(nil), header...),
+		cache:           cache,
+	}
+}
+
+func (fs *fileRecordStorage) record(row int) ([]byte, error) {
+	if row < 0 || row >= fs.numberOfRecords {
+		return nil, fmt.Errorf("row %d of the %d records in the table: %w", row, fs.numberOfRecords, ErrRowOutOfBounds)
+	}
+
+	page, pageErr := fs.page(row / fs.cache.recordsPerPage)
+	if pageErr != nil {
+		return nil, pageErr
+	}
+	offset := (row % fs.cache.recordsPerPage) * fs.recordLength
+	return page.data[offset : offset+fs.recordLength], nil
+}
+
+// page returns the cached page with the given index, reading it from the file if it is not cached. A page that cannot
+// be read is not cached, and the error reading it is returned, and reported again on flush.
+func (fs *fileRecordStorage) page(index int) (*recordPage, error) {
+	if element, cached := fs.cache.pages[index]; cached {
+		fs.cache.recency.MoveToFront(element)
+		return element.Value.(*recordPage), nil
+	}
+
+	firstRow := index * fs.cache.recordsPerPage
+	rowCount := max(min(fs.cache.recordsPerPage, fs.numberOfRecords-firstRow), 0)
+
+	page := &recordPage{index: index, data: make([]byte, rowCount*fs.recordLength)}
+	if _, readErr := fs.file.ReadAt(page.data, fs.recordOffset(firstRow)); readErr != nil {
+		readErr = fmt.Errorf("reading records from row %d: %w", firstRow, readErr)
+		if fs.readErr == nil {
+			fs.readErr = readErr
+		}
+		fs.recordError(readErr)
+		return nil, readErr
+	}
+	page.saved = append([]byte(nil), page.data...)
+
+	fs.cache.pages[index] = fs.cache.recency.PushFront(page)
+	for fs.cache.recency.Len() > fs.cache.capacity {
+		fs.evict(fs.cache.recency.Back())
+	}
+	return page, nil
+}
+
+// evict writes back the page held by the given cache element if it has changed, and removes it from the cache.
+func (fs *fileRecordStorage) evict(element *list.Element) {
+	page := element.Value.(*recordPage)
+	if writeErr := fs.writeBack(page); writeErr != nil {
+		fs.recordError(writeErr)
+	}
+	fs.cache.recency.Remove(element)
+	delete(fs.cache.pages, page.index)
+}
+
+// writeBack writes the page to the file if its content has changed since it was last read or written. Nothing is
+// written while an error reading records is pending.
+func (fs *fileRecordStorage) writeBack(page *recordPage) error {
+	if bytes.Equal(page.data, page.saved) {
+		return nil
+	}
+	if fs.readErr != nil {
+		return fmt.Errorf("writing records from row %d after an earlier read failed: %w", page.index*fs.cache.recordsPerPage, fs.readErr)
+	}
+	if fs.readOnly {
+		return fmt.Errorf("%w: records cannot be changed", ErrReadOnly)
+	}
+
+	firstRow := page.index * fs.cache.recordsPerPage
+	if _, writeErr := fs.file.WriteAt(page.data, fs.recordOffset(firstRow)); writeErr != nil {
+		return fmt.Errorf("writing records from row %d: %w", firstRow, writeErr)
+	}
+	copy(page.saved, page.data)
+	return nil
+}
+
+func (fs *fileRecordStorage) appendRecord(content []byte) error {
+	if fs.readOnly {
+		return fmt.Errorf("%w: records cannot be added", ErrReadOnly)
+	}
+
+	row := fs.numberOfRecords
+	offset := fs.recordOffset(row)
+	if _, writeErr := fs.file.WriteAt(append(append([]byte(nil), content...), eofMarker), offset); writeErr != nil {
+		return fmt.Errorf("appending record %d: %w", row, writeErr)
+	}
+	fs.numberOfRecords++
+
+	// The page the new record belongs to may be cached from before the record was added.
+	if element, cached := fs.cache.pages[row/fs.cache.recordsPerPage]; cached {
+		fs.evict(element)
+	}
+	return nil
+}
+
+// flush writes back the changed pages, and the header if it has changed, reporting the first error encountered since
+// the last flush. While an error reading records is pending, nothing is written, and the error is reported.
+func (fs *fileRecordStorage) flush(header []byte) error {
+	if fs.readErr != nil {
+		fs.readErr = nil
+		flushErr := fs.err
+		fs.err = nil
+		return flushErr
+	}
+
+	for element := fs.cache.recency.Front(); element != nil; element = element.Next() {
+		if writeErr := fs.writeBack(element.Value.(*recordPage)); writeErr != nil {
+			fs.recordError(writeErr)
+		}
+	}
+
+	if !bytes.Equal(header, fs.savedHeader) && fs.err == nil {
+		if fs.readOnly {
+			fs.recordError(fmt.Errorf("%w: header cannot be changed", ErrReadOnly))
+		} else if _, writeErr := fs.file.WriteAt(header, 0); writeErr != nil {
+			fs.recordError(fmt.Errorf("writing table header: %w", writeErr))
+		} else {
+			fs.savedHeader = append(fs.savedHeader[:0], header...)
+		}
+	}
+
+	flushErr := fs.err
+	fs.err = nil
+	return flushErr
+}
+
+func (fs *fileRecordStorage) close(header []byte) error {
+	flushErr := fs.flush(header)
+	if closeErr := fs.file.Close(); closeErr != nil && flushErr == nil {
+		return closeErr
+	}
+	return flushErr
+}
+
+func (fs *fileRecordStorage) recordOffset(row int) int64 {
+	return fs.headerLength + int64(row)*int64(fs.recordLength)
+}
+
+func (fs *fileRecordStorage) recordError(err error) {
+	if fs.err == nil {
+		fs.err = err
+	}
+}
@@ -2,7 +2,7 @@ package godbf
 
 import (
 	"errors"
-	"github.com/axgle/mahonia"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -23,6 +23,19 @@ const (
 	recordIsDeleted         = 0x2A
 
 	eofMarker byte = 0x1A
+
+	logicalTrue          = "T"
+	logicalFalse         = "F"
+	logicalUninitialised = "?"
+
+	dbaseIIIFileSignature         byte = 0x03
+	dbaseIIIWithMemoFileSignature byte = 0x83
+	dbaseIVWithMemoFileSignature  byte = 0x8B
+
+	foxProWithMemoFileSignature            byte = 0xF5
+	visualFoxProFileSignature              byte = 0x30
+	visualFoxProAutoIncrementFileSignature byte = 0x31
+	visualFoxProVarcharFileSignature       byte = 0x32
 )
 
 // DbfTable is an in-memory container for dbase formatted data, and state that helps manage that data.
@@ -39,11 +52,13 @@ type dbaseData struct {
 	header
 	records
 	eofMarker byte
+
+	memo memoStore // companion file holding memo field content, or nil if the table has none
 }
 
 // dbase file header information
 type header struct {
-	fileSignature uint8 // Valid dBASE III PLUS table file (03h without a memo .DBT file; 83h with a memo)
+	fileSignature uint8 // Valid dBASE III PLUS table file (03h without a memo .DBT file; 83h with a memo; 8Bh with a dBASE IV memo)
 	dateOfLastUpdate
 	numberOfRecords       uint32   // Number of records in the table.
 	numberOfBytesInHeader uint16   // Number of bytes in the header.
@@ -53,6 +68,12 @@ type header struct {
 	// columns of dbase file
 	fields          []FieldDescriptor
 	fieldTerminator byte
+
+	nullFlagsField        *FieldDescriptor // Visual FoxPro's hidden _NullFlags system field, or nil
+	nullFlagsRecordOffset int              // offset of the _NullFlags field content within each record
+	backlink              []byte           // Visual FoxPro's database container backlink, or nil
+
+	fieldProperties []byte // dBase 7 field properties structure following the field descriptors, or nil
 }
 
 // SetNumberOfRecordsFromBytes sets numberOfRecords from a byte array.
@@ -129,6 +150,12 @@ func (ud *dateOfLastUpdate) LastUpdated() time.Time {
 	return updateTime
 }
 
+// LastUpdated returns the date the table was last updated, as dateOfLastUpdate.LastUpdated interprets it.
+func (dt *DbfTable) LastUpdated() time.Time {
+	defer dt.lockForReading()()
+	return dt.dateOfLastUpdate.LastUpdated()
+}
+
 // LowDefTime takes a time.Time and returns a low-definition time.Time equivalent that follows the same simplification
 // approach as LastUpdated().
 func (ud *dateOfLastUpdate) LowDefTime(highDefTime time.Time) time.Time {
@@ -157,6 +184,10 @@ type tableManagement struct {
 	schemaLockable
 	createdFromScratch bool // used before adding new fields to increment nu
 	encodingSupport
+
+	showDeleted    bool  // whether row iteration includes deleted rows, as with dBase's SET DELETED OFF
+	truncateValues bool  // whether SetFieldValue truncates values too long for their field, rather than rejecting them
+	iterationErr   error // error that stopped the last iteration over the table's rows, returned by Err
 }
 
 // schemaLockable permits or denys updates to the database field-definitions. You can only add new records when the
@@ -167,20 +198,77 @@ type schemaLockable struct {
 
 // encoding provides text encoding support for DbfTable
 type encodingSupport struct {
-	textEncoding string
-	decoder      mahonia.Decoder
-	encoder      mahonia.Encoder
+	textEncoding   string
+	encoding       Encoding
+	strictEncoding bool
 }
 
-func (es *encodingSupport) UseEncoding(encoding string) {
+// UseEncoding reads and writes the table's text with the encoding of the given name, alias or code page number.
+// If there is no such encoding, an error matching ErrUnsupportedEncoding is returned, and the encoding is unchanged.
+func (es *encodingSupport) UseEncoding(encoding string) error {
+	textEncoding, encodingErr := encodingNamed(encoding)
+	if encodingErr != nil {
+		return encodingErr
+	}
 	es.textEncoding = encoding
-	es.encoder = mahonia.NewEncoder(encoding)
-	es.decoder = mahonia.NewDecoder(encoding)
+	es.encoding = textEncoding
+	return nil
+}
+
+// SetStrictEncoding controls whether text the table's encoding cannot represent, and content that does not decode to
+// text, is reported rather than substituted. With strict encoding off, the default, such characters are substituted,
+// with '?' when encoding, and U+FFFD when decoding. With it on, the methods setting and returning field values with an
+// error return an EncodingError, identifying the row and field, in their place. FieldValue, and the other methods
+// returning no error, always substitute.
+func (es *encodingSupport) SetStrictEncoding(on bool) {
+	es.strictEncoding = on
+}
+
+// encode returns text in the table's encoding, substituting characters the encoding cannot represent, along with an
+// error reporting them if strict encoding is on.
+func (es *encodingSupport) encode(text string) ([]byte, error) {
+	content, encodeErr := es.textCodec().Encode(text)
+	if !es.strictEncoding {
+		encodeErr = nil
+	}
+	return content, encodeErr
+}
+
+// decode returns the text of content in the table's encoding, substituting bytes that decode to no character, along
+// with an error reporting them if strict encoding is on.
+func (es *encodingSupport) decode(content []byte) (string, error) {
+	text, decodeErr := es.textCodec().Decode(content)
+	if !es.strictEncoding {
+		decodeErr = nil
+	}
+	return text, decodeErr
+}
+
+// encodingError returns an EncodingError reporting err for the given row and field index, or nil if err is nil.
+func (dt *DbfTable) encodingError(row int, fieldIndex int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &EncodingError{Row: row, FieldName: dt.fields[fieldIndex].name, Encoding: dt.textEncoding, Err: err}
+}
+
+// textCodec returns the table's Encoding, or UTF-8 for a table given an unsupported encoding when created.
+func (es *encodingSupport) textCodec() Encoding {
+	if es.encoding == nil {
+		return encodings["UTF-8"]
+	}
+	return es.encoding
 }
 
 // imageCache keeps a dbase table in memory as its byte array encoding
 type imageCache struct {
 	dataStore []byte
+
+	storage         recordStorage // records of a table opened with OpenFile, kept apart from its header in dataStore, or nil
+	fileName        string        // name of the file a table was opened from with OpenFile
+	savedMemoLength int           // length of the memo file of a table opened with OpenFile, when last saved
+
+	mapping *tableMapping // file a table opened with OpenMapped reads from, or nil
 }
 
 func (dt *DbfTable) AddBooleanField(fieldName string) (err error) {
@@ -203,12 +291,81 @@ func (dt *DbfTable) AddFloatField(fieldName string, length byte, decimalPlaces u
 	return dt.addField(fieldName, Float, length, decimalPlaces)
 }
 
+// AddIntegerField adds a Visual FoxPro Integer field to the table, storing 32-bit signed integers in binary.
+func (dt *DbfTable) AddIntegerField(fieldName string) (err error) {
+	return dt.addField(fieldName, Integer, Integer.fixedFieldLength(), Integer.decimalCountNotApplicable())
+}
+
+// AddCurrencyField adds a Visual FoxPro Currency field to the table, storing values to four decimal places in binary.
+func (dt *DbfTable) AddCurrencyField(fieldName string) (err error) {
+	return dt.addField(fieldName, Currency, Currency.fixedFieldLength(), currencyDecimalPlaces)
+}
+
+// AddDoubleField adds a Double field to the table, storing 64-bit floating point values in binary.
+// The field is a Visual FoxPro Double, or a dBase 7 Double for dBase 7 tables.
+// The decimal places only affect how the value is rendered as text.
+func (dt *DbfTable) AddDoubleField(fieldName string, decimalPlaces uint8) (err error) {
+	doubleType := dt.doubleType()
+	return dt.addField(fieldName, doubleType, doubleType.fixedFieldLength(), decimalPlaces)
+}
+
+// AddDateTimeField adds a field to the table storing a date and time of day in binary.
+// The field is a Visual FoxPro DateTime, or a dBase 7 Timestamp for dBase 7 tables.
+func (dt *DbfTable) AddDateTimeField(fieldName string) (err error) {
+	dateTimeType := dt.dateTimeType()
+	return dt.addField(fieldName, dateTimeType, dateTimeType.fixedFieldLength(), dateTimeType.decimalCountNotApplicable())
+}
+
+// AddMemoField adds a memo field to the table, creating a dBase III .DBT memo file (or a dBase IV style .DBT memo
+// file for dBase 7 tables) for the table to store memo content in if it does not already have one.
+func (dt *DbfTable) AddMemoField(fieldName string) (err error) {
+	if dt.isDbase7() {
+		return dt.addMemoBackedField(fieldName, Memo, func() memoStore { return newDbtMemo(dbaseIVMemo) }, dbaseVIIWithMemoFileSignature)
+	}
+	return dt.addMemoBackedField(fieldName, Memo, func() memoStore { return newDbtMemo(dbaseIIIMemo) }, dbaseIIIWithMemoFileSignature)
+}
+
+// AddGeneralField adds a FoxPro general (OLE object) field to the table, creating a FoxPro .FPT memo file for the
+// table to store object content in if it does not already have one.
+// An error is returned if the table already has a memo file that cannot store binary content.
+func (dt *DbfTable) AddGeneralField(fieldName string) (err error) {
+	return dt.addMemoBackedField(fieldName, General, func() memoStore { return newFptMemo(fptDefaultBlockSize) }, foxProWithMemoFileSignature)
+}
+
+// AddPictureField adds a FoxPro picture field to the table, creating a FoxPro .FPT memo file for the table to store
+// picture content in if it does not already have one.
+// An error is returned if the table already has a memo file that cannot store binary content.
+func (dt *DbfTable) AddPictureField(fieldName string) (err error) {
+	return dt.addMemoBackedField(fieldName, Picture, func() memoStore { return newFptMemo(fptDefaultBlockSize) }, foxProWithMemoFileSignature)
+}
+
+func (dt *DbfTable) addMemoBackedField(fieldName string, fieldType DbaseDataType, newMemo func() memoStore, fileSignature byte) error {
+	if _, isDbt := dt.memo.(*dbtMemo); isDbt && fieldType != Memo {
+		return errors.New("field type " + string(fieldType) + " needs a FoxPro .FPT memo file, but the table has a dBase .DBT memo file")
+	}
+
+	if addErr := dt.addField(fieldName, fieldType, fieldType.fixedFieldLength(), fieldType.decimalCountNotApplicable()); addErr != nil {
+		return addErr
+	}
+
+	if dt.memo == nil {
+		dt.memo = newMemo()
+		dt.fileSignature = fileSignature
+		dt.dataStore[0] = dt.fileSignature
+	}
+
+	return nil
+}
+
 func (dt *DbfTable) addField(fieldName string, fieldType DbaseDataType, length byte, decimalPlaces uint8) (err error) {
 	if dt.schemaLocked {
-		return errors.New("altering dbase table schema is not allowed once you start storing table data to or open an existing dbase file")
+		return errors.New("altering dbase table schema is not allowed once you start storing table data to or open an existing dbase file; use AddField, DropField, RenameField, ResizeField or ChangeFieldType instead")
 	}
 
-	normalizedFieldName := dt.normaliseFieldName(fieldName)
+	normalizedFieldName, nameErr := dt.normaliseFieldName(fieldName)
+	if nameErr != nil {
+		return nameErr
+	}
 
 	if dt.HasField(normalizedFieldName) {
 		return errors.New("Field name \"" + normalizedFieldName + "\" already exists")
@@ -219,8 +376,11 @@ func (dt *DbfTable) addField(fieldName string, fieldType DbaseDataType, length b
 	df.fieldType = fieldType
 	df.length = length
 	df.decimalPlaces = decimalPlaces
+	if fieldType == Autoincrement {
+		df.nextAutoincrementValue = 1
+	}
 
-	slice := dt.convertToByteSlice(df.name, fieldNameByteLength)
+	slice, _ := dt.convertToByteSlice(df.name, fieldNameByteLength)
 
 	// Field name in ASCII (max 10 chracters)
 	for i := 0; i < len(slice); i++ {
@@ -254,18 +414,15 @@ func (dt *DbfTable) addField(fieldName string, fieldType DbaseDataType, length b
 	return
 }
 
-func (dt *DbfTable) normaliseFieldName(name string) (s string) {
-	e := mahonia.NewEncoder(dt.textEncoding)
-	b := []byte(e.ConvertString(name))
-
-	if len(b) > maxUsableNameByteLength {
-		b = b[0:maxUsableNameByteLength]
+// normaliseFieldName returns name as the table holds it: in the table's encoding, and cut to the longest field name
+// the table allows. An EncodingError is returned if strict encoding is on, and name has characters the encoding
+// cannot represent.
+func (dt *DbfTable) normaliseFieldName(name string) (string, error) {
+	b, encodeErr := dt.convertToByteSlice(name, dt.maxFieldNameLength())
+	if encodeErr != nil {
+		return "", encodeErr
 	}
-
-	d := mahonia.NewDecoder(dt.textEncoding)
-	s = d.ConvertString(string(b))
-
-	return
+	return dt.textCodec().Decode(b)
 }
 
 /*
@@ -273,38 +430,56 @@ getByteSlice converts value to byte slice according to given encoding and return
 a slice that is fixedFieldLength equals to numberOfBytes or less if the string is shorter than
 numberOfBytes
 */
-func (dt *DbfTable) convertToByteSlice(value string, numberOfBytes int) (s []byte) {
-	e := mahonia.NewEncoder(dt.textEncoding)
-	b := []byte(e.ConvertString(value))
+func (dt *DbfTable) convertToByteSlice(value string, numberOfBytes int) ([]byte, error) {
+	b, encodeErr := dt.encode(value)
+	if encodeErr != nil {
+		return nil, &EncodingError{Row: -1, FieldName: value, Encoding: dt.textEncoding, Err: encodeErr}
+	}
 
-	if len(b) <= numberOfBytes {
-		s = b
-	} else {
-		s = b[0:numberOfBytes]
+	if len(b) > numberOfBytes {
+		b = b[0:numberOfBytes]
 	}
-	return
+	return b, nil
 }
 
 func (dt *DbfTable) updateDataStore() {
-	// first create a slice from initial 32 bytes of datastore as the foundation of the new slice
+	// first create a slice from initial 32 bytes (68 for dBase 7) of datastore as the foundation of the new slice
 	// later we will set this slice to dt.dataStore to create the new header slice
-	slice := dt.dataStore[:32]
+	slice := dt.dataStore[:dt.headerPrefixLength()]
 
 	// set dbase file signature
-	slice[0] = 0x03
+	slice[0] = dt.fileSignature
 
 	var lengthOfEachRecord uint16 = 0
 
 	for i := range dt.Fields() {
 		lengthOfEachRecord += uint16(dt.Fields()[i].length)
-		slice = append(slice, dt.Fields()[i].fieldStore[:]...)
+		if dt.isDbase7() {
+			slice = append(slice, dt.packLevel7FieldDescriptor(dt.Fields()[i])...)
+		} else {
+			slice = append(slice, dt.Fields()[i].fieldStore[:]...)
+		}
 
 		// don't forget to update fieldMap. We need it to find the index of a field name
 		dt.fieldMap[dt.Fields()[i].name] = i
 	}
 
+	if dt.isVisualFoxPro() {
+		var nullFlagsLength uint16
+		slice, nullFlagsLength = dt.packVisualFoxProHeader(slice)
+		lengthOfEachRecord += nullFlagsLength
+	}
+
 	slice = append(slice, fieldDescriptorArrayTerminator)
 
+	if dt.isVisualFoxPro() {
+		slice = dt.packBacklink(slice)
+	}
+
+	if dt.isDbase7() {
+		slice = dt.packFieldProperties(slice)
+	}
+
 	// now reset dt.dataStore slice with the updated one
 	dt.dataStore = slice
 
@@ -359,7 +534,7 @@ func (dt *DbfTable) HasField(fieldName string) bool {
 // If the field does not exist, or does not use decimal places, an error is returned.
 func (dt *DbfTable) DecimalPlacesInField(fieldName string) (uint8, error) {
 	if !dt.HasField(fieldName) {
-		return 0, errors.New("Field name \"" + fieldName + "\" does not exist. ")
+		return 0, &FieldNotFoundError{FieldName: fieldName}
 	}
 
 	for i := 0; i < len(dt.fields); i++ {
@@ -368,7 +543,7 @@ func (dt *DbfTable) DecimalPlacesInField(fieldName string) (uint8, error) {
 		}
 	}
 
-	return 0, errors.New("type of field \"" + fieldName + "\" is not Numeric or Float")
+	return 0, errors.New("type of field \"" + fieldName + "\" is not Numeric, Float or Double")
 }
 
 // AddNewRecord adds a new empty record to the table, and returns the index number of the record.
@@ -376,12 +551,24 @@ func (dt *DbfTable) AddNewRecord() (newRecordNumber int, addErr error) {
 	if dt.lengthOfEachRecord <= 1 {
 		return -1, errors.New("attempted to add record with no fields defined")
 	}
+	if writableErr := dt.verifyWritable(); writableErr != nil {
+		return -1, writableErr
+	}
 
 	dt.schemaLocked = true
 
 	newRecord := make([]byte, dt.lengthOfEachRecord)
 	newRecord[recordDeletionFlagIndex] = recordIsActive
-	dt.dataStore = append(dt.dataStore, newRecord...)
+
+	if dt.storage != nil {
+		if appendErr := dt.storage.appendRecord(newRecord); appendErr != nil {
+			return -1, appendErr
+		}
+	} else {
+		// the new record goes between the last record and the end-of-file marker
+		dt.dataStore = append(dt.dataStore[:len(dt.dataStore)-1], newRecord...)
+		dt.dataStore = append(dt.dataStore, dt.eofMarker)
+	}
 
 	// since row numbers are "0" based first we set newRecordNumber
 	// and then increment number of records in dbase table
@@ -395,17 +582,22 @@ func (dt *DbfTable) AddNewRecord() (newRecordNumber int, addErr error) {
 	dt.dataStore[7] = s[3]
 	//fmt.Printf("Number of rows after:%d\n", dt.numberOfRecords)
 
-	return newRecordNumber, nil
+	return newRecordNumber, dt.assignAutoincrementValues(newRecordNumber)
 }
 
 // NumberOfRecords returns the number of records in the table
 func (dt *DbfTable) NumberOfRecords() int {
+	defer dt.lockForReading()()
 	return int(dt.numberOfRecords)
 }
 
 // HasRecord returns true if the table has a record with the given number otherwise, false is returned.
 // Use this method before FieldValue() to avoid index-out-of-range errors.
 func (dt *DbfTable) HasRecord(recordNumber int) bool {
+	defer dt.lockForReading()()
+	if dt.storage != nil {
+		return recordNumber >= 0 && recordNumber < int(dt.numberOfRecords)
+	}
 	recordOffset := int(dt.numberOfBytesInHeader) + recordNumber*int(dt.lengthOfEachRecord)
 	return len(dt.dataStore) >= recordOffset+int(dt.lengthOfEachRecord)
 }
@@ -416,68 +608,87 @@ func (dt *DbfTable) SetFieldValueByName(row int, fieldName string, value string)
 	if fieldIndex, found := dt.fieldMap[fieldName]; found {
 		return dt.SetFieldValue(row, fieldIndex, value)
 	}
-	return errors.New("Field name \"" + fieldName + "\" does not exist")
+	return &FieldNotFoundError{FieldName: fieldName}
 }
 
 // SetFieldValue sets the value for the given row and field index as specified
-// If the field index is invalid, or the value is incompatible with the field's type, an error is returned.
+// Values are validated against the field's descriptor: Numeric and Float values must be numbers that fit the field's
+// width and decimal places, Date values must be in YYYYMMDD format, Logical values one of Y, y, T, t, N, n, F, f or ?,
+// and Character values must fit the field's length, unless SetTruncation(true) has been called.
+// If the row or field index is invalid, or the value is incompatible with the field, an error is returned, and the
+// field is left unchanged.
 func (dt *DbfTable) SetFieldValue(row int, fieldIndex int, value string) (err error) {
+	if writableErr := dt.verifyWritable(); writableErr != nil {
+		return writableErr
+	}
 
-	b := []byte(dt.encoder.ConvertString(value))
-
-	fieldLength := int(dt.fields[fieldIndex].length)
-
-	offset := int(dt.numberOfBytesInHeader)
-	lengthOfRecord := int(dt.lengthOfEachRecord)
-
-	offset = offset + (row * lengthOfRecord)
-
-	recordOffset := 1
-
-	for i := 0; i < len(dt.fields); i++ {
-		if i == fieldIndex {
-			break
-		} else {
-			recordOffset += int(dt.fields[i].length)
-		}
+	if row < 0 || !dt.HasRecord(row) {
+		return fmt.Errorf("row %d does not exist: %w", row, ErrRowOutOfBounds)
+	}
+	if fieldIndex < 0 || fieldIndex >= len(dt.fields) {
+		return fmt.Errorf("field index %d does not exist: %w", fieldIndex, ErrFieldNotFound)
 	}
 
-	dt.fillFieldWithBlanks(fieldLength, offset, recordOffset)
+	field := dt.fields[fieldIndex]
+	fieldBytes, bytesErr := dt.fieldBytes(row, fieldIndex)
+	if bytesErr != nil {
+		return bytesErr
+	}
 
-	// write new value
-	switch dt.fields[fieldIndex].fieldType {
-	case Character, Logical, Date:
-		for i := 0; i < len(b) && i < fieldLength; i++ {
-			dt.dataStore[offset+recordOffset+i] = b[i]
-		}
-	case Float, Numeric:
-		for i := 0; i < fieldLength; i++ {
-			if i < len(b) {
-				dt.dataStore[offset+recordOffset+(fieldLength-i-1)] = b[(len(b)-1)-i]
-			} else {
-				break
-			}
-		}
+	switch {
+	case dt.storesInMemo(field):
+		err = dt.setMemoValue(fieldBytes, value)
+	case dt.storesBinary(field):
+		err = dt.setBinaryFieldValue(field, fieldBytes, value)
+	default:
+		err = dt.setTextFieldValue(field, fieldBytes, value)
+	}
+	if errors.Is(err, ErrUnmappableCharacter) {
+		return dt.encodingError(row, fieldIndex, err)
+	}
+	if err != nil {
+		return err
 	}
 
-	return
+	dt.clearNull(row, fieldIndex)
+	return nil
 }
 
-func (dt *DbfTable) fillFieldWithBlanks(fieldLength int, offset int, recordOffset int) {
-	for i := 0; i < fieldLength; i++ {
-		dt.dataStore[offset+recordOffset+i] = blank
+func fillWithBlanks(fieldBytes []byte) {
+	for i := range fieldBytes {
+		fieldBytes[i] = blank
 	}
 }
 
-// FieldValue returns the content for the record at the given row and field index as a string
-// If the row or field index is invalid, an error is returned .
-func (dt *DbfTable) FieldValue(row int, fieldIndex int) (value string) {
+// recordBytes returns the slice of the table's data store (or of its record storage, for tables opened with
+// OpenFile) holding the content of the given row. If the row does not exist, or the record storage cannot read it, an
+// error is returned.
+func (dt *DbfTable) recordBytes(row int) ([]byte, error) {
+	defer dt.lockForReading()()
+	if row < 0 || row >= int(dt.numberOfRecords) {
+		return nil, fmt.Errorf("row %d does not exist: %w", row, ErrRowOutOfBounds)
+	}
+	if dt.storage != nil {
+		return dt.storage.record(row)
+	}
 
-	offset := int(dt.numberOfBytesInHeader)
-	lengthOfRecord := int(dt.lengthOfEachRecord)
+	offset := int(dt.numberOfBytesInHeader) + row*int(dt.lengthOfEachRecord)
+	return dt.dataStore[offset:(offset + int(dt.lengthOfEachRecord))], nil
+}
 
-	offset = offset + (row * lengthOfRecord)
+// fieldBytes returns the slice of the table's data store holding the content of the given row and field index. If the
+// row does not exist, or the record storage cannot read it, an error is returned.
+func (dt *DbfTable) fieldBytes(row int, fieldIndex int) ([]byte, error) {
+	record, recordErr := dt.recordBytes(row)
+	if recordErr != nil {
+		return nil, recordErr
+	}
+	recordOffset := dt.fieldRecordOffset(fieldIndex)
+	return record[recordOffset:(recordOffset + int(dt.fields[fieldIndex].length))], nil
+}
 
+// fieldRecordOffset returns the offset within each record of the content of the given field index.
+func (dt *DbfTable) fieldRecordOffset(fieldIndex int) int {
 	recordOffset := 1
 
 	for i := 0; i < len(dt.fields); i++ {
@@ -488,24 +699,46 @@ func (dt *DbfTable) FieldValue(row int, fieldIndex int) (value string) {
 		}
 	}
 
-	temp := dt.dataStore[(offset + recordOffset):((offset + recordOffset) + int(dt.fields[fieldIndex].length))]
+	return recordOffset
+}
+
+// FieldValue returns the content for the record at the given row and field index as a string
+// If the row or field index is invalid, an error is returned .
+func (dt *DbfTable) FieldValue(row int, fieldIndex int) (value string) {
+	value, _ = dt.fieldValue(row, fieldIndex)
+	return
+}
 
-	enforceBlankPadding(temp)
+// fieldValue returns the content for the record at the given row and field index as FieldValue does, along with an
+// EncodingError if strict encoding is on, and the content does not decode to text. If the record storage cannot read
+// the row, an error is returned.
+func (dt *DbfTable) fieldValue(row int, fieldIndex int) (string, error) {
+	temp, bytesErr := dt.fieldBytes(row, fieldIndex)
+	if bytesErr != nil {
+		return "", bytesErr
+	}
 
-	s := dt.decoder.ConvertString(string(temp))
-	value = strings.TrimSpace(s)
+	if dt.IsNull(row, fieldIndex) {
+		return "", nil
+	}
 
-	return
+	if dt.storesInMemo(dt.fields[fieldIndex]) {
+		return dt.memoValue(row, fieldIndex, temp)
+	}
+
+	if dt.storesBinary(dt.fields[fieldIndex]) {
+		return dt.binaryFieldValue(dt.fields[fieldIndex], temp), nil
+	}
+
+	s, decodeErr := dt.decode([]byte(enforceBlankPadding(temp)))
+	return strings.TrimSpace(s), dt.encodingError(row, fieldIndex, decodeErr)
 }
 
 // Some Dbf encoders pad with null chars instead of blanks, this forces blanks as per
 // https://www.dbase.com/Knowledgebase/INT/db7_file_fmt.htm
-func enforceBlankPadding(temp []byte) {
-	for i := 0; i < len(temp); i++ {
-		if temp[i] == null {
-			temp[i] = blank
-		}
-	}
+// The padded content is returned as a string, leaving the field's bytes unchanged.
+func enforceBlankPadding(temp []byte) string {
+	return strings.ReplaceAll(string(temp), string(null), string(blank))
 }
 
 // Float64FieldValueByName returns the value of a field given row number and name provided as a float64
@@ -520,40 +753,183 @@ func (dt *DbfTable) Int64FieldValueByName(row int, fieldName string) (value int6
 	return strconv.ParseInt(valueAsString, 0, 64)
 }
 
+// TimeFieldValueByName returns the value of a Date field given row number and name provided as a time.Time, at
+// midnight UTC. A field holding no date returns the zero time.Time.
+// If the field does not exist, is not a Date field, or does not hold a valid YYYYMMDD date, an error is returned.
+func (dt *DbfTable) TimeFieldValueByName(row int, fieldName string) (value time.Time, err error) {
+	fieldIndex, err := dt.typedFieldIndex(fieldName, Date)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	valueAsString := dt.FieldValue(row, fieldIndex)
+	if valueAsString == "" {
+		return time.Time{}, nil
+	}
+
+	value, parseErr := time.Parse(DateFormat, valueAsString)
+	if parseErr != nil {
+		return time.Time{}, fmt.Errorf("value %q of Date field \"%s\" is not a valid date; expected format %s", valueAsString, fieldName, DateFormat)
+	}
+	return value, nil
+}
+
+// SetTimeFieldValueByName sets the value of a Date field for the given row and field name to the date of value,
+// ignoring its time of day and location. A zero value clears the field.
+// If the field does not exist, or is not a Date field, an error is returned.
+func (dt *DbfTable) SetTimeFieldValueByName(row int, fieldName string, value time.Time) (err error) {
+	fieldIndex, err := dt.typedFieldIndex(fieldName, Date)
+	if err != nil {
+		return err
+	}
+
+	if value.IsZero() {
+		return dt.SetFieldValue(row, fieldIndex, "")
+	}
+	return dt.SetFieldValue(row, fieldIndex, value.Format(DateFormat))
+}
+
+// BoolFieldValueByName returns the value of a Logical field given row number and name provided.
+// Y, y, T and t are true, and N, n, F and f are false. isSet is false if the field holds the uninitialised value
+// '?', or is blank.
+// If the field does not exist, is not a Logical field, or holds any other value, an error is returned.
+func (dt *DbfTable) BoolFieldValueByName(row int, fieldName string) (value bool, isSet bool, err error) {
+	fieldIndex, err := dt.typedFieldIndex(fieldName, Logical)
+	if err != nil {
+		return false, false, err
+	}
+
+	value, isSet, err = parseLogical(dt.FieldValue(row, fieldIndex))
+	if err != nil {
+		return false, false, fmt.Errorf("Logical field \"%s\": %w", fieldName, err)
+	}
+	return value, isSet, nil
+}
+
+// parseLogical interprets the text content of a Logical field. isSet is false for the uninitialised value '?', or
+// an empty value.
+func parseLogical(value string) (logical bool, isSet bool, err error) {
+	switch value {
+	case "Y", "y", "T", "t":
+		return true, true, nil
+	case "N", "n", "F", "f":
+		return false, true, nil
+	case logicalUninitialised, "":
+		return false, false, nil
+	default:
+		return false, false, fmt.Errorf("value %q is not a valid logical value", value)
+	}
+}
+
+// SetBoolFieldValueByName sets the value of a Logical field for the given row and field name to T or F.
+// If the field does not exist, or is not a Logical field, an error is returned.
+func (dt *DbfTable) SetBoolFieldValueByName(row int, fieldName string, value bool) (err error) {
+	fieldIndex, err := dt.typedFieldIndex(fieldName, Logical)
+	if err != nil {
+		return err
+	}
+
+	if value {
+		return dt.SetFieldValue(row, fieldIndex, logicalTrue)
+	}
+	return dt.SetFieldValue(row, fieldIndex, logicalFalse)
+}
+
+// UnsetBoolFieldValueByName sets the value of a Logical field for the given row and field name to the uninitialised
+// value '?'.
+// If the field does not exist, or is not a Logical field, an error is returned.
+func (dt *DbfTable) UnsetBoolFieldValueByName(row int, fieldName string) (err error) {
+	fieldIndex, err := dt.typedFieldIndex(fieldName, Logical)
+	if err != nil {
+		return err
+	}
+	return dt.SetFieldValue(row, fieldIndex, logicalUninitialised)
+}
+
 // FieldValueByName returns the value of a field given row number and name provided
 func (dt *DbfTable) FieldValueByName(row int, fieldName string) (value string, err error) {
 	if fieldIndex, entryFound := dt.fieldMap[fieldName]; entryFound {
-		return dt.FieldValue(row, fieldIndex), err
+		return dt.fieldValue(row, fieldIndex)
 	}
-	err = errors.New("Field name \"" + fieldName + "\" does not exist")
+	err = &FieldNotFoundError{FieldName: fieldName}
 	return
 }
 
 // RowIsDeleted returns whether a row has marked as deleted
 func (dt *DbfTable) RowIsDeleted(row int) (bool, error) {
-	if row >= dt.NumberOfRecords() {
-		return false, errors.New("row out of bounds")
+	if row < 0 || row >= dt.NumberOfRecords() {
+		return false, ErrRowOutOfBounds
 	}
 
-	offset := int(dt.numberOfBytesInHeader)
-	lengthOfRecord := int(dt.lengthOfEachRecord)
-	offset = offset + (row * lengthOfRecord)
-	return dt.dataStore[offset:(offset + 1)][recordDeletionFlagIndex] == recordIsDeleted, nil
+	record, recordErr := dt.recordBytes(row)
+	if recordErr != nil {
+		return false, recordErr
+	}
+	return record[recordDeletionFlagIndex] == recordIsDeleted, nil
 }
 
 // SetRowIsDeleted sets a row as deleted
 func (dt *DbfTable) SetRowIsDeleted(row int) (err error) {
-	if row >= dt.NumberOfRecords() {
-		return errors.New("row out of bounds")
+	if row < 0 || row >= dt.NumberOfRecords() {
+		return ErrRowOutOfBounds
+	}
+	if writableErr := dt.verifyWritable(); writableErr != nil {
+		return writableErr
+	}
+
+	record, recordErr := dt.recordBytes(row)
+	if recordErr != nil {
+		return recordErr
+	}
+	record[recordDeletionFlagIndex] = recordIsDeleted
+	return
+}
+
+// RecallRow clears the deletion mark from a row, as dBase's RECALL command does.
+func (dt *DbfTable) RecallRow(row int) (err error) {
+	if row < 0 || row >= dt.NumberOfRecords() {
+		return ErrRowOutOfBounds
+	}
+	if writableErr := dt.verifyWritable(); writableErr != nil {
+		return writableErr
 	}
 
-	offset := int(dt.numberOfBytesInHeader)
-	lengthOfRecord := int(dt.lengthOfEachRecord)
-	offset = offset + (row * lengthOfRecord)
-	dt.dataStore[offset:(offset + 1)][recordDeletionFlagIndex] = recordIsDeleted
+	record, recordErr := dt.recordBytes(row)
+	if recordErr != nil {
+		return recordErr
+	}
+	record[recordDeletionFlagIndex] = recordIsActive
 	return
 }
 
+// SetDeleted controls whether row iteration skips rows marked as deleted, as dBase's SET DELETED command does.
+// With deleted set on, the default, ForEachRow and ForEachRecord skip deleted rows. With it set off, they include
+// them. Access to rows by row number is unaffected.
+func (dt *DbfTable) SetDeleted(on bool) {
+	dt.showDeleted = !on
+}
+
+// ForEachRow calls fn with the row number of each row in the table, in order, skipping rows marked as deleted unless
+// SetDeleted(false) has been called. Iteration stops at the first error fn returns, or at the first row the table
+// cannot read, and that error is returned.
+func (dt *DbfTable) ForEachRow(fn func(row int) error) error {
+	for row := range dt.RowNumbers() {
+		if fnErr := fn(row); fnErr != nil {
+			return fnErr
+		}
+	}
+	return dt.Err()
+}
+
+// ForEachRecord calls fn with the row number and field values, as GetRowAsSlice returns them, of each row
+// ForEachRow iterates over. Iteration stops at the first error fn returns, or at the first row the table cannot read,
+// and that error is returned.
+func (dt *DbfTable) ForEachRecord(fn func(row int, values []string) error) error {
+	return dt.ForEachRow(func(row int) error {
+		return fn(row, dt.GetRowAsSlice(row))
+	})
+}
+
 // GetRowAsSlice return the record values for the row specified as a string slice
 func (dt *DbfTable) GetRowAsSlice(row int) []string {
 
@@ -1,8 +1,11 @@
 package godbf
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 )
@@ -408,6 +411,113 @@ func TestDbfTable_Float64FieldValueByName(t *testing.T) {
 	g.Expect(actualFloatFieldValue).To(BeNumerically("==", expectedFloatValue))
 }
 
+func TestDbfTable_TimeFieldValueByName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+
+	dateFieldName := "dateField"
+	tableUnderTest.AddDateField(dateFieldName)
+
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+	emptyRecordIndex, _ := tableUnderTest.AddNewRecord()
+
+	setValue := time.Date(2018, time.December, 1, 13, 14, 15, 0, time.Local)
+	g.Expect(tableUnderTest.SetTimeFieldValueByName(recordIndex, dateFieldName, setValue)).To(Succeed())
+
+	g.Expect(tableUnderTest.FieldValueByName(recordIndex, dateFieldName)).To(Equal("20181201"))
+	g.Expect(tableUnderTest.TimeFieldValueByName(recordIndex, dateFieldName)).To(Equal(time.Date(2018, time.December, 1, 0, 0, 0, 0, time.UTC)))
+
+	g.Expect(tableUnderTest.TimeFieldValueByName(emptyRecordIndex, dateFieldName)).To(Equal(time.Time{}))
+
+	g.Expect(tableUnderTest.SetTimeFieldValueByName(recordIndex, dateFieldName, time.Time{})).To(Succeed())
+	g.Expect(tableUnderTest.FieldValueByName(recordIndex, dateFieldName)).To(Equal(""))
+}
+
+func TestDbfTable_TimeFieldValueByName_Invalid_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+
+	tableUnderTest.AddDateField("dateField")
+	tableUnderTest.AddTextField("textField", 8)
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+	g.Expect(tableUnderTest.SetFieldValueByName(recordIndex, "dateField", "notadate")).ToNot(Succeed())
+	fieldBytes, _ := tableUnderTest.fieldBytes(recordIndex, 0)
+	copy(fieldBytes, "notadate")
+
+	_, invalidError := tableUnderTest.TimeFieldValueByName(recordIndex, "dateField")
+	g.Expect(invalidError).ToNot(BeNil())
+	t.Log(invalidError)
+
+	_, typeError := tableUnderTest.TimeFieldValueByName(recordIndex, "textField")
+	g.Expect(typeError).ToNot(BeNil())
+
+	g.Expect(tableUnderTest.SetTimeFieldValueByName(recordIndex, "textField", time.Now())).ToNot(Succeed())
+}
+
+func TestDbfTable_BoolFieldValueByName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+
+	boolFieldName := "boolField"
+	tableUnderTest.AddBooleanField(boolFieldName)
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+
+	for _, storedValue := range []string{"Y", "y", "T", "t"} {
+		tableUnderTest.SetFieldValueByName(recordIndex, boolFieldName, storedValue)
+		value, isSet, valueError := tableUnderTest.BoolFieldValueByName(recordIndex, boolFieldName)
+		g.Expect(valueError).To(BeNil())
+		g.Expect(isSet).To(BeTrue())
+		g.Expect(value).To(BeTrue())
+	}
+
+	for _, storedValue := range []string{"N", "n", "F", "f"} {
+		tableUnderTest.SetFieldValueByName(recordIndex, boolFieldName, storedValue)
+		value, isSet, valueError := tableUnderTest.BoolFieldValueByName(recordIndex, boolFieldName)
+		g.Expect(valueError).To(BeNil())
+		g.Expect(isSet).To(BeTrue())
+		g.Expect(value).To(BeFalse())
+	}
+
+	for _, storedValue := range []string{"?", ""} {
+		tableUnderTest.SetFieldValueByName(recordIndex, boolFieldName, storedValue)
+		_, isSet, valueError := tableUnderTest.BoolFieldValueByName(recordIndex, boolFieldName)
+		g.Expect(valueError).To(BeNil())
+		g.Expect(isSet).To(BeFalse())
+	}
+
+	g.Expect(tableUnderTest.SetFieldValueByName(recordIndex, boolFieldName, "x")).ToNot(Succeed())
+	fieldBytes, _ := tableUnderTest.fieldBytes(recordIndex, 0)
+	copy(fieldBytes, "x")
+	_, _, invalidError := tableUnderTest.BoolFieldValueByName(recordIndex, boolFieldName)
+	g.Expect(invalidError).ToNot(BeNil())
+	t.Log(invalidError)
+}
+
+func TestDbfTable_SetBoolFieldValueByName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New(testEncoding)
+
+	boolFieldName := "boolField"
+	tableUnderTest.AddBooleanField(boolFieldName)
+	tableUnderTest.AddTextField("textField", 1)
+	recordIndex, _ := tableUnderTest.AddNewRecord()
+
+	g.Expect(tableUnderTest.SetBoolFieldValueByName(recordIndex, boolFieldName, true)).To(Succeed())
+	g.Expect(tableUnderTest.FieldValueByName(recordIndex, boolFieldName)).To(Equal("T"))
+
+	g.Expect(tableUnderTest.SetBoolFieldValueByName(recordIndex, boolFieldName, false)).To(Succeed())
+	g.Expect(tableUnderTest.FieldValueByName(recordIndex, boolFieldName)).To(Equal("F"))
+
+	g.Expect(tableUnderTest.UnsetBoolFieldValueByName(recordIndex, boolFieldName)).To(Succeed())
+	g.Expect(tableUnderTest.FieldValueByName(recordIndex, boolFieldName)).To(Equal("?"))
+
+	g.Expect(tableUnderTest.SetBoolFieldValueByName(recordIndex, "textField", true)).ToNot(Succeed())
+}
+
 func TestDbfTable_FieldDescriptor(t *testing.T) {
 	g := NewGomegaWithT(t)
 
@@ -511,3 +621,98 @@ func TestDbfTable_MiddleRowIsDeleted_(t *testing.T) {
 	g.Expect(tableUnderTest.RowIsDeleted(1)).To(BeTrue())
 	g.Expect(tableUnderTest.RowIsDeleted(2)).To(BeTrue())
 }
+
+func newDeletionTestTable(g *GomegaWithT) *DbfTable {
+	tableUnderTest := New(testEncoding)
+	g.Expect(tableUnderTest.AddTextField("NAME", 10)).To(Succeed())
+	for _, name := range []string{"alice", "bob", "carol"} {
+		row, _ := tableUnderTest.AddNewRecord()
+		g.Expect(tableUnderTest.SetFieldValueByName(row, "NAME", name)).To(Succeed())
+	}
+	return tableUnderTest
+}
+
+func TestDbfTable_RecallRow_DeletionCleared(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newDeletionTestTable(g)
+	g.Expect(tableUnderTest.SetRowIsDeleted(1)).To(Succeed())
+
+	g.Expect(tableUnderTest.RecallRow(1)).To(Succeed())
+
+	isDeleted, _ := tableUnderTest.RowIsDeleted(1)
+	g.Expect(isDeleted).To(BeFalse())
+	g.Expect(tableUnderTest.RecallRow(3)).ToNot(Succeed())
+}
+
+func TestDbfTable_RecallRow_NegativeRow_ErrorsAndHeaderUnchanged(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newDeletionTestTable(g)
+	originalContent := bytes.Clone(tableUnderTest.dataStore)
+
+	g.Expect(errors.Is(tableUnderTest.RecallRow(-1), ErrRowOutOfBounds)).To(BeTrue())
+	g.Expect(errors.Is(tableUnderTest.SetRowIsDeleted(-1), ErrRowOutOfBounds)).To(BeTrue())
+
+	_, deletedErr := tableUnderTest.RowIsDeleted(-1)
+	g.Expect(errors.Is(deletedErr, ErrRowOutOfBounds)).To(BeTrue())
+	g.Expect(tableUnderTest.dataStore).To(Equal(originalContent))
+}
+
+func TestDbfTable_ForEachRecord_DeletedRowsSkipped(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newDeletionTestTable(g)
+	tableUnderTest.SetRowIsDeleted(1)
+
+	var names []string
+	var rows []int
+	iterateErr := tableUnderTest.ForEachRecord(func(row int, values []string) error {
+		rows = append(rows, row)
+		names = append(names, values[0])
+		return nil
+	})
+
+	g.Expect(iterateErr).To(BeNil())
+	g.Expect(rows).To(Equal([]int{0, 2}))
+	g.Expect(names).To(Equal([]string{"alice", "carol"}))
+}
+
+func TestDbfTable_ForEachRow_SetDeletedOff_DeletedRowsIncluded(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newDeletionTestTable(g)
+	tableUnderTest.SetRowIsDeleted(1)
+	tableUnderTest.SetDeleted(false)
+
+	var rows []int
+	tableUnderTest.ForEachRow(func(row int) error {
+		rows = append(rows, row)
+		return nil
+	})
+	g.Expect(rows).To(Equal([]int{0, 1, 2}))
+
+	tableUnderTest.SetDeleted(true)
+	rows = nil
+	tableUnderTest.ForEachRow(func(row int) error {
+		rows = append(rows, row)
+		return nil
+	})
+	g.Expect(rows).To(Equal([]int{0, 2}))
+}
+
+func TestDbfTable_ForEachRow_FnErrors_IterationStops(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newDeletionTestTable(g)
+	stopErr := errors.New("stop")
+
+	var rows []int
+	iterateErr := tableUnderTest.ForEachRow(func(row int) error {
+		rows = append(rows, row)
+		return stopErr
+	})
+
+	g.Expect(iterateErr).To(Equal(stopErr))
+	g.Expect(rows).To(Equal([]int{0}))
+}
@@ -0,0 +1,313 @@
+package godbf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+)
+
+// transcodedValue is the text of a field of a table being transcoded, held while the table's encoding changes.
+type transcodedValue struct {
+	row        int
+	fieldIndex int
+	value      string
+	decodeErr  error // the error decoding the value, with strict encoding on, or nil
+}
+
+// Transcode re-encodes the text of the table in the target encoding, which may be named by any of its aliases, or its
+// code page number: the field names, the value of every Character field, and the text of every Memo field. The
+// language driver ID in the table's header is changed to the one identifying the target encoding's code page, or to
+// 0 if no documented ID does, as for UTF-8.
+//
+// Values that no longer fit their field once re-encoded are left empty, and reported in the failures returned, unless
+// SetTruncation(true) has been called, in which case they are truncated as SetFieldValue truncates them. Characters
+// the target encoding cannot represent are substituted, unless SetStrictEncoding(true) has been called, in which case
+// their values are also left empty, and reported. Field names are always shortened, and substituted, to fit, given a
+// numbered suffix should that make them the same as another field's name. A field name that had to change is reported
+// as a failure with a Row of -1, its new name as the FieldName, and its previous name as the Value.
+// Visual FoxPro fields flagged as binary are left unchanged.
+// Memo text replaced stays in the table's memo file until the table is packed.
+//
+// If the target encoding is not supported, or the table cannot have its schema altered, an error is returned, and the
+// table is left unchanged.
+func Transcode(dt *DbfTable, targetEncoding string) ([]ConversionFailure, error) {
+	if writableErr := dt.verifyWritable(); writableErr != nil {
+		return nil, writableErr
+	}
+	if dt.storage != nil {
+		return nil, errors.New("tables opened with OpenFile cannot be transcoded")
+	}
+
+	values := dt.transcodedValues()
+
+	sourceEncoding := dt.encodingSupport
+	if encodingErr := dt.UseEncoding(targetEncoding); encodingErr != nil {
+		return nil, encodingErr
+	}
+
+	sourceFields := dt.Fields()
+	if renameErr := dt.renameTranscodedFields(sourceFields); renameErr != nil {
+		dt.encodingSupport = sourceEncoding
+		return nil, renameErr
+	}
+
+	var failures []ConversionFailure
+	for i, field := range dt.fields {
+		if field.name != sourceFields[i].name {
+			failures = append(failures, ConversionFailure{
+				Row:       -1,
+				FieldName: field.name,
+				Value:     sourceFields[i].name,
+				Err:       fmt.Errorf("field name %q is %q in %s", sourceFields[i].name, field.name, targetEncoding),
+			})
+		}
+	}
+
+	languageDriver, _ := LanguageDriverForEncoding(targetEncoding)
+	dt.dataStore[languageDriverOffset] = byte(languageDriver)
+
+	for _, value := range values {
+		if failure := dt.setTranscodedValue(value); failure != nil {
+			failures = append(failures, *failure)
+		}
+	}
+
+	slices.SortStableFunc(failures, func(a, b ConversionFailure) int { return a.Row - b.Row })
+	return failures, nil
+}
+
+// renameTranscodedFields renames the table's fields, given as they were before transcoding, to their
+// transcodedFieldNames. Strict encoding is off while they are renamed, as field names are substituted, and reported,
+// rather than refused.
+func (dt *DbfTable) renameTranscodedFields(sourceFields []FieldDescriptor) error {
+	defer func(strictEncoding bool) { dt.strictEncoding = strictEncoding }(dt.strictEncoding)
+	dt.strictEncoding = false
+
+	fields := slices.Clone(dt.fields)
+	for i, name := range dt.transcodedFieldNames(sourceFields) {
+		fields[i].name = name
+	}
+	_, alterErr := dt.alterFields(fields, identitySources(len(fields)))
+	return alterErr
+}
+
+// transcodedFieldNames returns the names of the given fields as the table's encoding represents them, shortened to
+// fit, and with characters it cannot represent substituted. Names made the same as an earlier name by doing so are
+// given a numbered suffix, keeping them unique.
+func (dt *DbfTable) transcodedFieldNames(fields []FieldDescriptor) []string {
+	names := make([]string, len(fields))
+	taken := make(map[string]bool)
+	for i, field := range fields {
+		name, _ := dt.normaliseFieldName(field.name)
+		for suffix := 2; taken[name]; suffix++ {
+			suffixText := "_" + strconv.Itoa(suffix)
+			nameBytes, _ := dt.convertToByteSlice(field.name, dt.maxFieldNameLength()-len(suffixText))
+			base, _ := dt.textCodec().Decode(nameBytes)
+			name = base + suffixText
+		}
+		taken[name] = true
+		names[i] = name
+	}
+	return names
+}
+
+// transcodedValues returns the text of the table's Character and Memo fields, decoded in its current encoding, for
+// every record where it is not empty, or does not decode.
+func (dt *DbfTable) transcodedValues() []transcodedValue {
+	var values []transcodedValue
+	for fieldIndex, field := range dt.fields {
+		if !isTranscoded(field) {
+			continue
+		}
+
+		for row := range int(dt.numberOfRecords) {
+			value, valueErr := dt.transcodedFieldValue(row, fieldIndex)
+			if value != "" || valueErr != nil {
+				values = append(values, transcodedValue{row: row, fieldIndex: fieldIndex, value: value, decodeErr: valueErr})
+			}
+		}
+	}
+	return values
+}
+
+// isTranscoded returns true if the field holds text that Transcode re-encodes.
+func isTranscoded(field FieldDescriptor) bool {
+	return (field.fieldType == Character || field.fieldType == Memo) && !field.IsBinary()
+}
+
+// transcodedFieldValue returns the text of the Character or Memo field at the given row and field index, or "" for a
+// field that is null, or a Memo field holding binary content.
+func (dt *DbfTable) transcodedFieldValue(row int, fieldIndex int) (string, error) {
+	if dt.IsNull(row, fieldIndex) {
+		return "", nil
+	}
+	if dt.fields[fieldIndex].fieldType != Memo {
+		return dt.fieldValue(row, fieldIndex)
+	}
+
+	memoValue, memoErr := dt.MemoFieldValue(row, fieldIndex)
+	if memoErr != nil {
+		return "", memoErr
+	}
+	text, _ := memoValue.(string)
+	return text, nil
+}
+
+// setTranscodedValue sets the field of the value to its text, in the table's encoding. If it cannot be, or the value
+// did not decode, the field is left empty, and the failure returned.
+func (dt *DbfTable) setTranscodedValue(value transcodedValue) *ConversionFailure {
+	setErr := value.decodeErr
+	switch {
+	case setErr != nil:
+	case dt.fields[value.fieldIndex].fieldType == Memo:
+		setErr = dt.SetMemoFieldValue(value.row, value.fieldIndex, value.value)
+	default:
+		setErr = dt.SetFieldValue(value.row, value.fieldIndex, value.value)
+	}
+	if setErr == nil {
+		return nil
+	}
+
+	dt.clearTranscodedField(value.row, value.fieldIndex)
+	return &ConversionFailure{Row: value.row, FieldName: dt.fields[value.fieldIndex].name, Value: value.value, Err: setErr}
+}
+
+// clearTranscodedField sets the Character or Memo field at the given row and field index to empty.
+func (dt *DbfTable) clearTranscodedField(row int, fieldIndex int) {
+	if dt.fields[fieldIndex].fieldType == Memo {
+		dt.SetMemoFieldValue(row, fieldIndex, "")
+		return
+	}
+	dt.SetFieldValue(row, fieldIndex, "")
+}
+
+// TranscodeFile re-encodes the table file of the source file name, as Transcode does, writing it to the target file
+// name. Records are read and written one at a time, so that tables of any size can be transcoded. The source file is
+// read with the supplied source encoding, which may be AutoEncoding. A .cpg file naming the target encoding is written
+// alongside the target file, so that reading it with AutoEncoding finds the encoding even where no language driver ID
+// identifies it.
+//
+// Unlike Transcode, characters the target encoding cannot represent, and source content that does not decode, are
+// always reported in the failures returned, with their fields left empty, rather than substituted.
+// The target file, and its .cpg file, are written as SaveToFile writes a table, so are left intact should transcoding
+// fail. The target may be the source file itself.
+//
+// Tables with memo fields cannot be streamed, and are rejected with an error: load them with NewFromFile, Transcode
+// them, and save them with SaveToFile instead.
+func TranscodeFile(sourceFileName string, sourceEncoding string, targetFileName string, targetEncoding string) ([]ConversionFailure, error) {
+	source, openErr := fsWrapper.Open(sourceFileName)
+	if openErr != nil {
+		return nil, openErr
+	}
+	defer source.Close()
+
+	reader, readerErr := NewReader(source, withCodePageFile(sourceFileName, sourceEncoding))
+	if readerErr != nil {
+		return nil, readerErr
+	}
+	if reader.table.hasMemoFields() {
+		return nil, errors.New("tables with memo fields cannot be transcoded file to file, use Transcode and SaveToFile")
+	}
+	reader.SetStrictEncoding(true)
+
+	schema, schemaFailures, schemaErr := transcodedSchema(reader.table, targetEncoding)
+	if schemaErr != nil {
+		return nil, schemaErr
+	}
+	failures := schemaFailures
+
+	files := []savedFile{
+		{
+			name: targetFileName,
+			write: func(f io.Writer) error {
+				recordFailures, writeErr := transcodeRecords(reader, schema, f)
+				failures = append(failures, recordFailures...)
+				return writeErr
+			},
+		},
+		{
+			name: companionFileName(targetFileName, cpgFileExtension),
+			write: func(f io.Writer) error {
+				_, writeErr := io.WriteString(f, codePageFileContent(targetEncoding))
+				return writeErr
+			},
+		},
+	}
+
+	if saveErr := saveFiles(files); saveErr != nil {
+		return nil, saveErr
+	}
+	return failures, nil
+}
+
+// transcodedSchema returns a table with no records, holding the fields of the table header of the supplied table,
+// transcoded to the target encoding, along with the field names that had to change. Strict encoding is on for the
+// records written with it.
+func transcodedSchema(headerTable *DbfTable, targetEncoding string) (*DbfTable, []ConversionFailure, error) {
+	schemaBytes := slices.Clone(headerTable.dataStore[:headerTable.numberOfBytesInHeader])
+	copy(schemaBytes[4:8], uint32ToBytes(0))
+	schemaBytes = append(schemaBytes, eofMarker)
+
+	schema, schemaErr := NewFromByteArray(schemaBytes, headerTable.textEncoding)
+	if schemaErr != nil {
+		return nil, nil, schemaErr
+	}
+	schema.SetStrictEncoding(true)
+
+	failures, transcodeErr := Transcode(schema, targetEncoding)
+	if transcodeErr != nil {
+		return nil, nil, transcodeErr
+	}
+	return schema, failures, nil
+}
+
+// transcodeRecords writes the records read by the reader to the destination, as a table with the fields of the
+// schema, re-encoding their Character fields in the schema's encoding. It returns the values that could not be
+// re-encoded, which are left empty.
+func transcodeRecords(reader *Reader, schema *DbfTable, destination io.Writer) ([]ConversionFailure, error) {
+	writer, writerErr := NewWriterWithCount(destination, schema, reader.NumberOfRecords())
+	if writerErr != nil {
+		return nil, writerErr
+	}
+
+	var failures []ConversionFailure
+	for reader.Next() {
+		writeErr := writer.writeRecord(func(recordBytes []byte) error {
+			copy(recordBytes, reader.table.dataStore[reader.table.numberOfBytesInHeader:])
+
+			for fieldIndex, field := range writer.table.fields {
+				if !isTranscoded(field) {
+					continue
+				}
+
+				value, valueErr := reader.table.transcodedFieldValue(0, fieldIndex)
+				transcoded := transcodedValue{fieldIndex: fieldIndex, value: value, decodeErr: valueErr}
+				if failure := writer.table.setTranscodedValue(transcoded); failure != nil {
+					failure.Row = reader.Row()
+					failure.Err = reader.atCurrentRow(failure.Err)
+					failures = append(failures, *failure)
+				}
+			}
+			return nil
+		})
+		if writeErr != nil {
+			return nil, writeErr
+		}
+	}
+	if readErr := reader.Err(); readErr != nil {
+		return nil, readErr
+	}
+
+	return failures, writer.Close()
+}
+
+// codePageFileContent returns the content of a .cpg file naming the given encoding: its code page number, where a
+// language driver ID identifies it, or its name otherwise.
+func codePageFileContent(encoding string) string {
+	if codePage, found := CodePageForEncoding(encoding); found {
+		return strconv.Itoa(codePage)
+	}
+	return canonicalEncoding(encoding)
+}
@@ -0,0 +1,265 @@
+package godbf
+
+import (
+	"bytes"
+	"errors"
+	. "github.com/onsi/gomega"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTranscodeTestTable(g *GomegaWithT, encoding string, names ...string) *DbfTable {
+	table := New(encoding)
+	g.Expect(table.AddTextField("ИМЯ", 12)).To(Succeed())
+	g.Expect(table.AddNumberField("AGE", 3, 0)).To(Succeed())
+
+	for i, name := range names {
+		row, _ := table.AddNewRecord()
+		g.Expect(table.SetFieldValueByName(row, "ИМЯ", name)).To(Succeed())
+		g.Expect(table.SetFieldValueByName(row, "AGE", "4"+string(rune('0'+i)))).To(Succeed())
+	}
+	return table
+}
+
+func TestTranscode_Cp866ToWindows1251_TextAndLanguageDriverConverted(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newTranscodeTestTable(g, "IBM866", "Привет", "Мир")
+
+	failures, transcodeErr := Transcode(tableUnderTest, "1251")
+	g.Expect(transcodeErr).To(BeNil())
+	g.Expect(failures).To(BeEmpty())
+
+	g.Expect(tableUnderTest.LanguageDriver()).To(Equal(LanguageDriver(0xC9)))
+	g.Expect(tableUnderTest.FieldNames()).To(Equal([]string{"ИМЯ", "AGE"}))
+
+	var content bytes.Buffer
+	g.Expect(writeContent(tableUnderTest, &content)).To(Succeed())
+
+	reloadedTable, loadErr := NewFromByteArray(content.Bytes(), AutoEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(reloadedTable.TextEncoding()).To(Equal("windows-1251"))
+	g.Expect(reloadedTable.FieldNames()).To(Equal([]string{"ИМЯ", "AGE"}))
+	g.Expect(reloadedTable.GetRowAsSlice(0)).To(Equal([]string{"Привет", "40"}))
+	g.Expect(reloadedTable.GetRowAsSlice(1)).To(Equal([]string{"Мир", "41"}))
+}
+
+func TestTranscode_ToUtf8_TooLongValuesReported(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newTranscodeTestTable(g, "windows-1251", "Привет", "Привет, мир")
+
+	failures, transcodeErr := Transcode(tableUnderTest, "UTF-8")
+	g.Expect(transcodeErr).To(BeNil())
+
+	g.Expect(tableUnderTest.LanguageDriver()).To(Equal(LanguageDriver(0)))
+	g.Expect(tableUnderTest.FieldValueByName(0, "ИМЯ")).To(Equal("Привет"))
+	g.Expect(tableUnderTest.FieldValueByName(1, "ИМЯ")).To(Equal(""))
+	g.Expect(tableUnderTest.FieldValueByName(1, "AGE")).To(Equal("41"))
+
+	g.Expect(failures).To(HaveLen(1))
+	g.Expect(failures[0].Row).To(Equal(1))
+	g.Expect(failures[0].FieldName).To(Equal("ИМЯ"))
+	g.Expect(failures[0].Value).To(Equal("Привет, мир"))
+	g.Expect(failures[0].Err).ToNot(BeNil())
+}
+
+func TestTranscode_ToUtf8WithTruncation_TooLongValuesTruncated(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newTranscodeTestTable(g, "windows-1251", "Привет, мир")
+	tableUnderTest.SetTruncation(true)
+
+	failures, transcodeErr := Transcode(tableUnderTest, "UTF-8")
+	g.Expect(transcodeErr).To(BeNil())
+	g.Expect(failures).To(BeEmpty())
+	g.Expect(tableUnderTest.FieldValueByName(0, "ИМЯ")).To(Equal("Привет"))
+}
+
+func TestTranscode_UnmappableCharacters_SubstitutedUnlessStrict(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newTranscodeTestTable(g, "UTF-8", "café", "Жук")
+	g.Expect(tableUnderTest.RenameField("ИМЯ", "NAME")).To(Succeed())
+
+	failures, transcodeErr := Transcode(tableUnderTest, "windows-1252")
+	g.Expect(transcodeErr).To(BeNil())
+	g.Expect(failures).To(BeEmpty())
+	g.Expect(tableUnderTest.LanguageDriver()).To(Equal(LanguageDriver(0x03)))
+	g.Expect(tableUnderTest.GetRowAsSlice(0)).To(Equal([]string{"café", "40"}))
+	g.Expect(tableUnderTest.GetRowAsSlice(1)).To(Equal([]string{"???", "41"}))
+
+	strictTable := newTranscodeTestTable(g, "UTF-8", "café", "Жук")
+	g.Expect(strictTable.RenameField("ИМЯ", "NAME")).To(Succeed())
+	strictTable.SetStrictEncoding(true)
+
+	failures, transcodeErr = Transcode(strictTable, "windows-1252")
+	g.Expect(transcodeErr).To(BeNil())
+	g.Expect(failures).To(HaveLen(1))
+	g.Expect(failures[0].Row).To(Equal(1))
+	g.Expect(errors.Is(failures[0].Err, ErrUnmappableCharacter)).To(BeTrue())
+	g.Expect(strictTable.GetRowAsSlice(0)).To(Equal([]string{"café", "40"}))
+	g.Expect(strictTable.GetRowAsSlice(1)).To(Equal([]string{"", "41"}))
+}
+
+func TestTranscode_FieldNameChanged_Reported(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New("windows-1251")
+	g.Expect(tableUnderTest.AddTextField("ФАМИЛИЯ", 10)).To(Succeed())
+
+	failures, transcodeErr := Transcode(tableUnderTest, "UTF-8")
+	g.Expect(transcodeErr).To(BeNil())
+
+	g.Expect(failures).To(HaveLen(1))
+	g.Expect(failures[0].Row).To(Equal(-1))
+	g.Expect(failures[0].Value).To(Equal("ФАМИЛИЯ"))
+	g.Expect(failures[0].FieldName).To(Equal(tableUnderTest.FieldNames()[0]))
+	g.Expect(failures[0].FieldName).ToNot(Equal("ФАМИЛИЯ"))
+}
+
+func TestTranscode_FieldNamesSubstitutedAlike_MadeUnique(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := New("windows-1251")
+	g.Expect(tableUnderTest.AddTextField("ФАМИЛИЯ", 10)).To(Succeed())
+	g.Expect(tableUnderTest.AddTextField("ОТЧЕСТВ", 10)).To(Succeed())
+	tableUnderTest.SetStrictEncoding(true)
+
+	failures, transcodeErr := Transcode(tableUnderTest, "windows-1252")
+	g.Expect(transcodeErr).To(BeNil())
+	g.Expect(tableUnderTest.FieldNames()).To(Equal([]string{"???????", "???????_2"}))
+
+	g.Expect(failures).To(HaveLen(2))
+	g.Expect(failures[0].Row).To(Equal(-1))
+	g.Expect(failures[0].Value).To(Equal("ФАМИЛИЯ"))
+	g.Expect(failures[0].FieldName).To(Equal("???????"))
+	g.Expect(failures[1].Row).To(Equal(-1))
+	g.Expect(failures[1].Value).To(Equal("ОТЧЕСТВ"))
+	g.Expect(failures[1].FieldName).To(Equal("???????_2"))
+}
+
+func TestTranscode_UnsupportedEncoding_TableUnchanged(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newTranscodeTestTable(g, "IBM866", "Привет")
+	originalContent := bytes.Clone(tableUnderTest.dataStore)
+
+	_, transcodeErr := Transcode(tableUnderTest, "no-such-encoding")
+	g.Expect(errors.Is(transcodeErr, ErrUnsupportedEncoding)).To(BeTrue())
+	g.Expect(tableUnderTest.dataStore).To(Equal(originalContent))
+	g.Expect(tableUnderTest.TextEncoding()).To(Equal("IBM866"))
+}
+
+func TestTranscode_MemoText_Converted(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newEncodingTestTable("IBM866")
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "NOTES", "Привет")).To(Succeed())
+
+	failures, transcodeErr := Transcode(tableUnderTest, "windows-1251")
+	g.Expect(transcodeErr).To(BeNil())
+	g.Expect(failures).To(BeEmpty())
+
+	g.Expect(tableUnderTest.FieldValueByName(0, "NOTES")).To(Equal("Привет"))
+
+	memoValue, memoErr := tableUnderTest.MemoFieldValueByName(0, "NOTES")
+	g.Expect(memoErr).To(BeNil())
+	g.Expect(memoValue).To(Equal("Привет"))
+}
+
+func TestTranscodeFile_Cp866ToUtf8_StreamedWithCodePageFile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	sourceTable := newTranscodeTestTable(g, "IBM866", "Привет", "Привет, мир", "Мир")
+	g.Expect(sourceTable.SetRowIsDeleted(2)).To(Succeed())
+
+	directory := t.TempDir()
+	sourceFileName := filepath.Join(directory, "source.dbf")
+	targetFileName := filepath.Join(directory, "target.dbf")
+	g.Expect(SaveToFile(sourceTable, sourceFileName)).To(Succeed())
+
+	failures, transcodeErr := TranscodeFile(sourceFileName, AutoEncoding, targetFileName, "UTF-8")
+	g.Expect(transcodeErr).To(BeNil())
+
+	g.Expect(failures).To(HaveLen(1))
+	g.Expect(failures[0].Row).To(Equal(1))
+	g.Expect(failures[0].FieldName).To(Equal("ИМЯ"))
+	g.Expect(failures[0].Value).To(Equal("Привет, мир"))
+
+	cpgContent, readErr := os.ReadFile(companionFileName(targetFileName, cpgFileExtension))
+	g.Expect(readErr).To(BeNil())
+	g.Expect(string(cpgContent)).To(Equal("UTF-8"))
+
+	targetTable, loadErr := NewFromFile(targetFileName, AutoEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(targetTable.TextEncoding()).To(Equal("UTF-8"))
+	g.Expect(targetTable.LanguageDriver()).To(Equal(LanguageDriver(0)))
+	g.Expect(targetTable.FieldNames()).To(Equal([]string{"ИМЯ", "AGE"}))
+	g.Expect(targetTable.NumberOfRecords()).To(Equal(3))
+	g.Expect(targetTable.GetRowAsSlice(0)).To(Equal([]string{"Привет", "40"}))
+	g.Expect(targetTable.GetRowAsSlice(1)).To(Equal([]string{"", "41"}))
+	g.Expect(targetTable.GetRowAsSlice(2)).To(Equal([]string{"Мир", "42"}))
+	g.Expect(targetTable.RowIsDeleted(2)).To(BeTrue())
+}
+
+func TestTranscodeFile_ToCodePage_CodePageFileHoldsNumber(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	directory := t.TempDir()
+	tableFileName := filepath.Join(directory, "table.dbf")
+	g.Expect(SaveToFile(newTranscodeTestTable(g, "IBM866", "Привет"), tableFileName)).To(Succeed())
+
+	failures, transcodeErr := TranscodeFile(tableFileName, "IBM866", tableFileName, "windows-1251")
+	g.Expect(transcodeErr).To(BeNil())
+	g.Expect(failures).To(BeEmpty())
+
+	cpgContent, _ := os.ReadFile(companionFileName(tableFileName, cpgFileExtension))
+	g.Expect(string(cpgContent)).To(Equal("1251"))
+
+	targetTable, loadErr := NewFromFile(tableFileName, AutoEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(targetTable.LanguageDriver()).To(Equal(LanguageDriver(0xC9)))
+	g.Expect(targetTable.GetRowAsSlice(0)).To(Equal([]string{"Привет", "40"}))
+}
+
+func TestTranscodeFile_UnrepresentableFieldName_Substituted(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	sourceTable := New("windows-1251")
+	g.Expect(sourceTable.AddTextField("ФАМИЛИЯ", 10)).To(Succeed())
+	row, _ := sourceTable.AddNewRecord()
+	g.Expect(sourceTable.SetFieldValueByName(row, "ФАМИЛИЯ", "Smith")).To(Succeed())
+
+	directory := t.TempDir()
+	sourceFileName := filepath.Join(directory, "source.dbf")
+	targetFileName := filepath.Join(directory, "target.dbf")
+	g.Expect(SaveToFile(sourceTable, sourceFileName)).To(Succeed())
+
+	failures, transcodeErr := TranscodeFile(sourceFileName, AutoEncoding, targetFileName, "windows-1252")
+	g.Expect(transcodeErr).To(BeNil())
+
+	g.Expect(failures).To(HaveLen(1))
+	g.Expect(failures[0].Row).To(Equal(-1))
+	g.Expect(failures[0].Value).To(Equal("ФАМИЛИЯ"))
+
+	targetTable, loadErr := NewFromFile(targetFileName, AutoEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(targetTable.FieldNames()).To(Equal([]string{"???????"}))
+	g.Expect(targetTable.GetRowAsSlice(0)).To(Equal([]string{"Smith"}))
+}
+
+func TestTranscodeFile_MemoFields_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	directory := t.TempDir()
+	tableFileName := filepath.Join(directory, "memo.dbf")
+	g.Expect(SaveToFile(newEncodingTestTable("IBM866"), tableFileName)).To(Succeed())
+
+	_, transcodeErr := TranscodeFile(tableFileName, "IBM866", filepath.Join(directory, "target.dbf"), "UTF-8")
+	g.Expect(transcodeErr).ToNot(BeNil())
+
+	_, statErr := os.Stat(filepath.Join(directory, "target.dbf"))
+	g.Expect(errors.Is(statErr, os.ErrNotExist)).To(BeTrue())
+}
@@ -0,0 +1,146 @@
+package godbf
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// numberPattern matches the text of a Numeric or Float value: an optional sign, and digits with an optional decimal
+// point.
+var numberPattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)$`)
+
+// SetTruncation controls whether SetFieldValue truncates values too long for their field. With truncation off, the
+// default, such values are rejected with a FieldValueError. With it on, Character values are cut after the last whole
+// character that fits the field's length, and Numeric and Float values lose the decimal places beyond those the field
+// allows. Numeric and Float values whose integer part does not fit, beside the field's decimal places, are always
+// rejected.
+func (dt *DbfTable) SetTruncation(on bool) {
+	dt.truncateValues = on
+}
+
+// setTextFieldValue validates value for the text-stored field fd, storing it in fieldBytes if it is valid.
+// SetFieldValue stores Character, Numeric, Float, Logical and Date fields as text this way, checking values against
+// the field's descriptor first, so that a value the field cannot hold is reported rather than silently mangled.
+// Character, Logical and Date values are left-aligned, and Numeric and Float values right-aligned, padded with blanks.
+func (dt *DbfTable) setTextFieldValue(fd FieldDescriptor, fieldBytes []byte, value string) error {
+	content, contentErr := dt.textFieldContent(fd, value)
+	if contentErr != nil {
+		return contentErr
+	}
+
+	fillWithBlanks(fieldBytes)
+	switch fd.fieldType {
+	case Float, Numeric:
+		copy(fieldBytes[len(fieldBytes)-len(content):], content)
+	default:
+		copy(fieldBytes, content)
+	}
+	return nil
+}
+
+// textFieldContent returns the encoded content to store for value in the text-stored field fd, or a FieldValueError
+// if the field cannot hold it.
+func (dt *DbfTable) textFieldContent(fd FieldDescriptor, value string) ([]byte, error) {
+	switch fd.fieldType {
+	case Numeric, Float:
+		number, numberErr := dt.fitNumber(fd, value)
+		if numberErr != nil {
+			return nil, numberErr
+		}
+		return []byte(number), nil
+	case Logical:
+		logical := strings.TrimSpace(value)
+		if _, _, parseErr := parseLogical(logical); parseErr != nil {
+			return nil, &FieldValueError{FieldName: fd.name, FieldType: fd.fieldType, Value: value, Reason: "expected one of Y, y, T, t, N, n, F, f or ?"}
+		}
+		return []byte(logical), nil
+	case Date:
+		date := strings.TrimSpace(value)
+		if date == "" {
+			return nil, nil
+		}
+		if _, parseErr := time.Parse(DateFormat, date); parseErr != nil || len(date) != len(DateFormat) {
+			return nil, &FieldValueError{FieldName: fd.name, FieldType: fd.fieldType, Value: value, Reason: "expected a date in YYYYMMDD format"}
+		}
+		return []byte(date), nil
+	default:
+		content, encodeErr := dt.encode(value)
+		if encodeErr != nil {
+			return nil, encodeErr
+		}
+		if len(content) <= int(fd.length) {
+			return content, nil
+		}
+		if dt.truncateValues {
+			return dt.truncatedContent(value, int(fd.length)), nil
+		}
+		reason := fmt.Sprintf("it is %d bytes long, but the field holds %d", len(content), fd.length)
+		return nil, &FieldValueError{FieldName: fd.name, FieldType: fd.fieldType, Value: value, Reason: reason}
+	}
+}
+
+// truncatedContent returns the encoded content of the longest run of whole characters from the start of value that
+// fits in the given number of bytes. Each character encodes to at least one byte, so no more characters than bytes are
+// tried.
+func (dt *DbfTable) truncatedContent(value string, length int) []byte {
+	end := 0
+	for characters := 0; characters < length && end < len(value); characters++ {
+		_, size := utf8.DecodeRuneInString(value[end:])
+		end += size
+	}
+
+	for end > 0 {
+		if content, _ := dt.encode(value[:end]); len(content) <= length {
+			return content
+		}
+		_, size := utf8.DecodeLastRuneInString(value[:end])
+		end -= size
+	}
+	return nil
+}
+
+// fitNumber returns the text of value as it fits the Numeric or Float field fd, or a FieldValueError if the value is
+// not a number, or does not fit. An empty value is returned as is.
+func (dt *DbfTable) fitNumber(fd FieldDescriptor, value string) (string, error) {
+	number := strings.TrimSpace(value)
+	if number == "" {
+		return "", nil
+	}
+	if !numberPattern.MatchString(number) {
+		return "", &FieldValueError{FieldName: fd.name, FieldType: fd.fieldType, Value: value, Reason: "it is not a number"}
+	}
+
+	integerPart, fraction, _ := strings.Cut(number, ".")
+	if integerWidth := fd.integerWidth(); len(integerPart) > integerWidth {
+		reason := fmt.Sprintf("its integer part is %d characters wide, but the field holds %d", len(integerPart), integerWidth)
+		return "", &FieldValueError{FieldName: fd.name, FieldType: fd.fieldType, Value: value, Reason: reason}
+	}
+	if len(fraction) > int(fd.decimalPlaces) {
+		if !dt.truncateValues {
+			reason := fmt.Sprintf("it has %d decimal places, but the field allows %d", len(fraction), fd.decimalPlaces)
+			return "", &FieldValueError{FieldName: fd.name, FieldType: fd.fieldType, Value: value, Reason: reason}
+		}
+		if strings.Trim(integerPart, "+-") == "" {
+			integerPart += "0" // a value such as .5 keeps a digit once its decimal places are truncated
+		}
+		number = strings.TrimSuffix(integerPart+"."+fraction[:fd.decimalPlaces], ".")
+	}
+
+	if len(number) > int(fd.length) {
+		reason := fmt.Sprintf("it is %d characters wide, but the field holds %d", len(number), fd.length)
+		return "", &FieldValueError{FieldName: fd.name, FieldType: fd.fieldType, Value: value, Reason: reason}
+	}
+	return number, nil
+}
+
+// integerWidth returns the number of characters, sign included, the Numeric or Float field fd holds before its decimal
+// point: its length, less its decimal places and the decimal point, if it has any.
+func (fd *FieldDescriptor) integerWidth() int {
+	if fd.decimalPlaces == 0 {
+		return int(fd.length)
+	}
+	return max(int(fd.length)-int(fd.decimalPlaces)-1, 0)
+}
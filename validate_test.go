@@ -0,0 +1,139 @@
+package godbf
+
+import (
+	"errors"
+	. "github.com/onsi/gomega"
+	"testing"
+)
+
+func newValidationTestTable() *DbfTable {
+	table := New(testEncoding)
+	table.AddTextField("NAME", 5)
+	table.AddNumberField("AMOUNT", 6, 2)
+	table.AddNumberField("COUNT", 3, 0)
+	table.AddDateField("BORN")
+	table.AddBooleanField("ACTIVE")
+	table.AddNewRecord()
+	return table
+}
+
+func TestDbfTable_SetFieldValue_ValidValues_Stored(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newValidationTestTable()
+
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "NAME", "alice")).To(Succeed())
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "AMOUNT", "-12.5")).To(Succeed())
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "COUNT", " 42 ")).To(Succeed())
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "BORN", "20240229")).To(Succeed())
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "ACTIVE", "y")).To(Succeed())
+
+	g.Expect(tableUnderTest.GetRowAsSlice(0)).To(Equal([]string{"alice", "-12.5", "42", "20240229", "y"}))
+	g.Expect(tableUnderTest.fieldBytes(0, 1)).To(Equal([]byte(" -12.5")))
+}
+
+func TestDbfTable_SetFieldValue_EmptyValues_Stored(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newValidationTestTable()
+
+	for fieldIndex := range tableUnderTest.Fields() {
+		g.Expect(tableUnderTest.SetFieldValue(0, fieldIndex, "")).To(Succeed())
+	}
+	g.Expect(tableUnderTest.GetRowAsSlice(0)).To(Equal([]string{"", "", "", "", ""}))
+}
+
+func TestDbfTable_SetFieldValue_InvalidValues_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newValidationTestTable()
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "NAME", "bob")).To(Succeed())
+
+	invalidValues := []struct {
+		fieldName string
+		value     string
+	}{
+		{"NAME", "charlotte"},
+		{"AMOUNT", "abc"},
+		{"AMOUNT", "1e5"},
+		{"AMOUNT", "1.234"},
+		{"AMOUNT", "12345.6"},
+		{"AMOUNT", "1234"},
+		{"AMOUNT", "-123"},
+		{"COUNT", "1234"},
+		{"COUNT", "1.5"},
+		{"BORN", "2024-02-29"},
+		{"BORN", "20230229"},
+		{"ACTIVE", "true"},
+		{"ACTIVE", "x"},
+	}
+
+	for _, invalid := range invalidValues {
+		setErr := tableUnderTest.SetFieldValueByName(0, invalid.fieldName, invalid.value)
+		t.Log(setErr)
+
+		g.Expect(errors.Is(setErr, ErrInvalidFieldValue)).To(BeTrue(), invalid.fieldName+" "+invalid.value)
+
+		var valueErr *FieldValueError
+		g.Expect(errors.As(setErr, &valueErr)).To(BeTrue())
+		g.Expect(valueErr.FieldName).To(Equal(invalid.fieldName))
+		g.Expect(valueErr.Value).To(Equal(invalid.value))
+	}
+
+	g.Expect(tableUnderTest.GetRowAsSlice(0)).To(Equal([]string{"bob", "", "", "", ""}))
+}
+
+func TestDbfTable_SetFieldValue_InvalidIndexes_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newValidationTestTable()
+
+	g.Expect(errors.Is(tableUnderTest.SetFieldValue(1, 0, "bob"), ErrRowOutOfBounds)).To(BeTrue())
+	g.Expect(errors.Is(tableUnderTest.SetFieldValue(-1, 0, "bob"), ErrRowOutOfBounds)).To(BeTrue())
+	g.Expect(errors.Is(tableUnderTest.SetFieldValue(0, 5, "bob"), ErrFieldNotFound)).To(BeTrue())
+	g.Expect(errors.Is(tableUnderTest.SetFieldValue(0, -1, "bob"), ErrFieldNotFound)).To(BeTrue())
+}
+
+func TestDbfTable_SetTruncation_TruncatesTooLongValues(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newValidationTestTable()
+	tableUnderTest.SetTruncation(true)
+
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "NAME", "charlotte")).To(Succeed())
+	g.Expect(tableUnderTest.FieldValueByName(0, "NAME")).To(Equal("charl"))
+
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "AMOUNT", "1.239")).To(Succeed())
+	g.Expect(tableUnderTest.FieldValueByName(0, "AMOUNT")).To(Equal("1.23"))
+
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "AMOUNT", "-12.345")).To(Succeed())
+	g.Expect(tableUnderTest.FieldValueByName(0, "AMOUNT")).To(Equal("-12.34"))
+
+	g.Expect(errors.Is(tableUnderTest.SetFieldValueByName(0, "AMOUNT", "1234.5"), ErrInvalidFieldValue)).To(BeTrue())
+	g.Expect(errors.Is(tableUnderTest.SetFieldValueByName(0, "AMOUNT", "12345"), ErrInvalidFieldValue)).To(BeTrue())
+	g.Expect(tableUnderTest.FieldValueByName(0, "AMOUNT")).To(Equal("-12.34"))
+
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "COUNT", "-.5")).To(Succeed())
+	g.Expect(tableUnderTest.FieldValueByName(0, "COUNT")).To(Equal("-0"))
+
+	g.Expect(errors.Is(tableUnderTest.SetFieldValueByName(0, "COUNT", "1234.5"), ErrInvalidFieldValue)).To(BeTrue())
+	g.Expect(errors.Is(tableUnderTest.SetFieldValueByName(0, "BORN", "202402290"), ErrInvalidFieldValue)).To(BeTrue())
+	g.Expect(tableUnderTest.FieldValueByName(0, "COUNT")).To(Equal("-0"))
+}
+
+func TestDbfTable_SetTruncation_CutsAtWholeCharacters(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest := newValidationTestTable()
+	tableUnderTest.SetTruncation(true)
+	g.Expect(tableUnderTest.SetFieldValueByName(0, "NAME", "ééé")).To(Succeed())
+	g.Expect(tableUnderTest.FieldValueByName(0, "NAME")).To(Equal("éé"))
+	g.Expect(tableUnderTest.fieldBytes(0, 0)).To(Equal([]byte("éé ")))
+
+	doubleByteTable := New("GBK")
+	g.Expect(doubleByteTable.AddTextField("NAME", 5)).To(Succeed())
+	doubleByteTable.SetTruncation(true)
+	row, _ := doubleByteTable.AddNewRecord()
+	g.Expect(doubleByteTable.SetFieldValueByName(row, "NAME", "中文字")).To(Succeed())
+	g.Expect(doubleByteTable.FieldValueByName(row, "NAME")).To(Equal("中文"))
+}
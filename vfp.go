@@ -0,0 +1,197 @@
+package godbf
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Visual FoxPro tables extend the dBase header with a flags byte in each field descriptor, a hidden _NullFlags system
+// field recording which nullable fields of a record hold null, and a 263-byte backlink to the database container
+// (.DBC) the table belongs to, directly after the field descriptor array terminator.
+// For reference: https://learn.microsoft.com/en-us/previous-versions/visualstudio/foxpro/st4a0s68(v=vs.80)
+
+const (
+	visualFoxProBacklinkLength = 263
+
+	fieldDisplacementOffset = 12
+	fieldFlagsOffset        = 18
+
+	systemFieldFlag   byte = 0x01
+	nullableFieldFlag byte = 0x02
+	binaryFieldFlag   byte = 0x04
+)
+
+// NullFlags is the data type of Visual FoxPro's hidden _NullFlags system field.
+const NullFlags DbaseDataType = '0'
+
+// IsSystem returns true if the field is a Visual FoxPro system field, hidden from users of the table.
+func (fd *FieldDescriptor) IsSystem() bool {
+	return fd.flags&systemFieldFlag != 0
+}
+
+// IsNullable returns true if the field is a Visual FoxPro field that may hold null.
+func (fd *FieldDescriptor) IsNullable() bool {
+	return fd.flags&nullableFieldFlag != 0
+}
+
+// IsBinary returns true if the field is a Visual FoxPro field whose content is exempt from code page translation.
+func (fd *FieldDescriptor) IsBinary() bool {
+	return fd.flags&binaryFieldFlag != 0
+}
+
+// isVisualFoxPro returns true if the file signature identifies a Visual FoxPro table.
+func (h *header) isVisualFoxPro() bool {
+	switch h.fileSignature {
+	case visualFoxProFileSignature, visualFoxProAutoIncrementFileSignature, visualFoxProVarcharFileSignature:
+		return true
+	default:
+		return false
+	}
+}
+
+// Backlink returns the relative path of the database container a Visual FoxPro table belongs to, or an empty string
+// if the table is not part of a database container.
+func (dt *DbfTable) Backlink() string {
+	end := 0
+	for end < len(dt.backlink) && dt.backlink[end] != null {
+		end++
+	}
+	return string(dt.backlink[:end])
+}
+
+// IsNullByName returns whether the field given row number and name provided holds null.
+// Only nullable Visual FoxPro fields can hold null.
+func (dt *DbfTable) IsNullByName(row int, fieldName string) (bool, error) {
+	if fieldIndex, entryFound := dt.fieldMap[fieldName]; entryFound {
+		return dt.IsNull(row, fieldIndex), nil
+	}
+	return false, &FieldNotFoundError{FieldName: fieldName}
+}
+
+// IsNull returns whether the field at the given row and field index holds null.
+// Only nullable Visual FoxPro fields can hold null.
+func (dt *DbfTable) IsNull(row int, fieldIndex int) bool {
+	nullFlags, bit, isNullable, _ := dt.nullFlagBit(row, fieldIndex)
+	if !isNullable {
+		return false
+	}
+	return nullFlags[bit/8]&(1<<(bit%8)) != 0
+}
+
+// SetNullByName sets the field for the given row and field name to null.
+// If the field does not exist, or is not nullable, an error is returned.
+func (dt *DbfTable) SetNullByName(row int, fieldName string) error {
+	if writableErr := dt.verifyWritable(); writableErr != nil {
+		return writableErr
+	}
+
+	fieldIndex, entryFound := dt.fieldMap[fieldName]
+	if !entryFound {
+		return &FieldNotFoundError{FieldName: fieldName}
+	}
+
+	nullFlags, bit, isNullable, recordErr := dt.nullFlagBit(row, fieldIndex)
+	if recordErr != nil {
+		return recordErr
+	}
+	if !isNullable {
+		return errors.New("Field \"" + fieldName + "\" is not nullable")
+	}
+
+	fieldBytes, _ := dt.fieldBytes(row, fieldIndex) // the record was read above
+	fillWithBlanks(fieldBytes)
+	nullFlags[bit/8] |= 1 << (bit % 8)
+	return nil
+}
+
+// clearNull marks the field at the given row and field index as not holding null, if it is nullable.
+func (dt *DbfTable) clearNull(row int, fieldIndex int) {
+	if nullFlags, bit, isNullable, _ := dt.nullFlagBit(row, fieldIndex); isNullable {
+		nullFlags[bit/8] &^= 1 << (bit % 8)
+	}
+}
+
+// nullFlagBit returns the _NullFlags content of the given row, and the bit within it that records whether the field
+// at the given index holds null. Nullable fields are allocated bits in field order, starting at the least significant
+// bit of the first byte. isNullable is false if the field cannot hold null, or the record storage cannot read the row,
+// in which case an error is returned.
+func (dt *DbfTable) nullFlagBit(row int, fieldIndex int) (nullFlags []byte, bit int, isNullable bool, err error) {
+	if dt.nullFlagsField == nil || !dt.fields[fieldIndex].IsNullable() {
+		return nil, 0, false, nil
+	}
+
+	for i := 0; i < fieldIndex; i++ {
+		if dt.fields[i].IsNullable() {
+			bit++
+		}
+	}
+
+	record, recordErr := dt.recordBytes(row)
+	if recordErr != nil {
+		return nil, 0, false, recordErr
+	}
+	nullFlags = record[dt.nullFlagsRecordOffset : dt.nullFlagsRecordOffset+int(dt.nullFlagsField.length)]
+
+	if bit/8 >= len(nullFlags) {
+		return nil, 0, false, nil
+	}
+	return nullFlags, bit, true, nil
+}
+
+// unpackNullFlagsField records the _NullFlags system field, starting at the given offset within each record, apart
+// from the table's user-visible fields.
+func unpackNullFlagsField(s []byte, dt *DbfTable, offset int, recordOffset int) error {
+	name, nameErr := deriveFieldName(s, dt, offset)
+	if nameErr != nil {
+		return nameErr
+	}
+
+	nullFlagsField := new(FieldDescriptor)
+	nullFlagsField.name = name
+	nullFlagsField.fieldType = NullFlags
+	nullFlagsField.length = s[offset+16]
+	nullFlagsField.flags = s[offset+fieldFlagsOffset]
+	copy(nullFlagsField.fieldStore[:], s[offset:offset+32])
+
+	dt.nullFlagsField = nullFlagsField
+	dt.nullFlagsRecordOffset = recordOffset
+	return nil
+}
+
+// unpackBacklink records the database container backlink following the field descriptor array terminator at the
+// given offset.
+func unpackBacklink(s []byte, dt *DbfTable, terminatorOffset int) {
+	start := terminatorOffset + 1
+	end := min(start+visualFoxProBacklinkLength, int(dt.numberOfBytesInHeader), len(s))
+	if start >= end {
+		return
+	}
+	dt.backlink = append([]byte(nil), s[start:end]...)
+}
+
+// packVisualFoxProHeader appends the _NullFlags field descriptor (if any) to the field descriptors of a header under
+// construction, and records each field's displacement within the record as Visual FoxPro expects. It returns the
+// updated header, and the record length the _NullFlags field adds.
+func (dt *DbfTable) packVisualFoxProHeader(slice []byte) ([]byte, uint16) {
+	displacement := uint32(1)
+	for i := range dt.fields {
+		descriptor := slice[32+i*32 : 32+(i+1)*32]
+		binary.LittleEndian.PutUint32(descriptor[fieldDisplacementOffset:], displacement)
+		displacement += uint32(dt.fields[i].length)
+	}
+
+	if dt.nullFlagsField == nil {
+		return slice, 0
+	}
+
+	dt.nullFlagsRecordOffset = int(displacement)
+	binary.LittleEndian.PutUint32(dt.nullFlagsField.fieldStore[fieldDisplacementOffset:], displacement)
+	return append(slice, dt.nullFlagsField.fieldStore[:]...), uint16(dt.nullFlagsField.length)
+}
+
+// packBacklink appends the database container backlink to a Visual FoxPro header under construction.
+func (dt *DbfTable) packBacklink(slice []byte) []byte {
+	backlink := make([]byte, visualFoxProBacklinkLength)
+	copy(backlink, dt.backlink)
+	return append(slice, backlink...)
+}
@@ -0,0 +1,130 @@
+package godbf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+const testBacklink = "..\\data\\test.dbc"
+
+// visualFoxProTestBytes encodes a Visual FoxPro table with a nullable NAME Character field, an AMOUNT Integer field,
+// and a _NullFlags system field, holding two records, the second of which has a null NAME.
+func visualFoxProTestBytes() []byte {
+	const fieldCount = 3
+	const recordLength = 1 + 6 + 4 + 1
+	headerLength := 32 + fieldCount*32 + 1 + visualFoxProBacklinkLength
+
+	data := make([]byte, headerLength)
+	data[0] = visualFoxProFileSignature
+	data[1], data[2], data[3] = 119, 1, 2
+	copy(data[4:8], uint32ToBytes(2))
+	copy(data[8:10], uint32ToBytes(uint32(headerLength)))
+	copy(data[10:12], uint32ToBytes(recordLength))
+	data[29] = 0x03
+
+	fieldDescriptor := func(index int, name string, fieldType DbaseDataType, displacement byte, length byte, flags byte) {
+		descriptor := data[32+index*32 : 32+(index+1)*32]
+		copy(descriptor, name)
+		descriptor[11] = fieldType.byte()
+		descriptor[12] = displacement
+		descriptor[16] = length
+		descriptor[18] = flags
+	}
+	fieldDescriptor(0, "NAME", Character, 1, 6, nullableFieldFlag)
+	fieldDescriptor(1, "AMOUNT", Integer, 7, 4, 0)
+	fieldDescriptor(2, "_NullFlags", NullFlags, 11, 1, systemFieldFlag|binaryFieldFlag)
+
+	data[32+fieldCount*32] = fieldDescriptorArrayTerminator
+	copy(data[32+fieldCount*32+1:], testBacklink)
+
+	data = append(data, ' ', 'a', 'l', 'i', 'c', 'e', ' ', 0x2A, 0x00, 0x00, 0x00, 0x00)
+	data = append(data, ' ', ' ', ' ', ' ', ' ', ' ', ' ', 0x07, 0x00, 0x00, 0x00, 0x01)
+	return append(data, eofMarker)
+}
+
+func TestNewFromByteArray_VisualFoxPro_FieldsAreCorrect(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, newErr := NewFromByteArray(visualFoxProTestBytes(), testEncoding)
+	g.Expect(newErr).To(BeNil())
+
+	g.Expect(tableUnderTest.FieldNames()).To(Equal([]string{"NAME", "AMOUNT"}))
+	g.Expect(tableUnderTest.Fields()[0].IsNullable()).To(BeTrue())
+	g.Expect(tableUnderTest.Fields()[1].IsNullable()).To(BeFalse())
+	g.Expect(tableUnderTest.nullFlagsField.IsSystem()).To(BeTrue())
+	g.Expect(tableUnderTest.nullFlagsField.IsBinary()).To(BeTrue())
+	g.Expect(tableUnderTest.Backlink()).To(Equal(testBacklink))
+}
+
+func TestNewFromByteArray_VisualFoxPro_NullsAreHonoured(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromByteArray(visualFoxProTestBytes(), testEncoding)
+
+	g.Expect(tableUnderTest.GetRowAsSlice(0)).To(Equal([]string{"alice", "42"}))
+	g.Expect(tableUnderTest.IsNullByName(0, "NAME")).To(BeFalse())
+
+	g.Expect(tableUnderTest.GetRowAsSlice(1)).To(Equal([]string{"", "7"}))
+	g.Expect(tableUnderTest.IsNullByName(1, "NAME")).To(BeTrue())
+	g.Expect(tableUnderTest.IsNullByName(1, "AMOUNT")).To(BeFalse())
+}
+
+func TestDbfTable_SetNullByName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromByteArray(visualFoxProTestBytes(), testEncoding)
+
+	g.Expect(tableUnderTest.SetNullByName(0, "NAME")).To(Succeed())
+	g.Expect(tableUnderTest.IsNullByName(0, "NAME")).To(BeTrue())
+	g.Expect(tableUnderTest.FieldValueByName(0, "NAME")).To(Equal(""))
+
+	g.Expect(tableUnderTest.SetFieldValueByName(1, "NAME", "bob")).To(Succeed())
+	g.Expect(tableUnderTest.IsNullByName(1, "NAME")).To(BeFalse())
+	g.Expect(tableUnderTest.FieldValueByName(1, "NAME")).To(Equal("bob"))
+}
+
+func TestDbfTable_SetNullByName_NotNullable_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableUnderTest, _ := NewFromByteArray(visualFoxProTestBytes(), testEncoding)
+
+	setError := tableUnderTest.SetNullByName(0, "AMOUNT")
+	g.Expect(setError).ToNot(BeNil())
+	t.Log(setError)
+
+	missingError := tableUnderTest.SetNullByName(0, "missingField")
+	g.Expect(missingError).ToNot(BeNil())
+}
+
+func TestSaveToFile_VisualFoxPro_BacklinkIsPreserved(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tableToSave, _ := NewFromByteArray(visualFoxProTestBytes(), testEncoding)
+
+	tempFilename := filepath.Join("testdata", "tempSavedVisualFoxProTable.dbf")
+	g.Expect(SaveToFile(tableToSave, tempFilename)).To(Succeed())
+
+	tableUnderTest, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+
+	g.Expect(tableUnderTest.Backlink()).To(Equal(testBacklink))
+	g.Expect(tableUnderTest.IsNullByName(1, "NAME")).To(BeTrue())
+
+	g.Expect(os.Remove(tempFilename)).To(Succeed())
+}
+
+func TestDbfTable_UpdateDataStore_VisualFoxProHeaderIsRebuilt(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	originalBytes := visualFoxProTestBytes()
+	tableUnderTest, _ := NewFromByteArray(visualFoxProTestBytes(), testEncoding)
+
+	tableUnderTest.updateDataStore()
+
+	headerLength := int(tableUnderTest.numberOfBytesInHeader)
+	g.Expect(tableUnderTest.dataStore[:headerLength]).To(Equal(originalBytes[:headerLength]))
+	g.Expect(tableUnderTest.lengthOfEachRecord).To(BeNumerically("==", 12))
+}
@@ -0,0 +1,235 @@
+package godbf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Writer writes a dbase table to an io.Writer one record at a time, holding only the table header and the current
+// record in memory.
+//
+// The record count in the table header is only known once all records are written. A Writer created by NewWriter
+// seeks back to rewrite the header when closed, so needs an io.WriteSeeker. A Writer created by NewWriterWithCount
+// writes the record count up front, so can write to any io.Writer, but must be given exactly that many records.
+// A Writer created by OpenForAppend adds records to the end of an existing table file.
+type Writer struct {
+	destination     io.Writer
+	table           *DbfTable // header of the table, followed by space for the current record at row 0
+	headerOffset    int64     // offset of the table header within a seekable destination
+	expectedRecords int       // the record count written up front, or -1 if the header is rewritten on Close
+	existingRecords int       // records already in the destination before the Writer was created
+	recordsWritten  int
+	closed          bool
+
+	file io.Closer // file the Writer opened, and closes on Close, or nil
+}
+
+// NewWriter creates a Writer for tables with the fields of the supplied schema table, writing the table header to
+// the supplied io.WriteSeeker. The header's record count is updated when the Writer is closed.
+// If the schema has memo fields, or the header cannot be written, an error is returned.
+func NewWriter(destination io.WriteSeeker, schema *DbfTable) (*Writer, error) {
+	headerOffset, seekErr := destination.Seek(0, io.SeekCurrent)
+	if seekErr != nil {
+		return nil, seekErr
+	}
+	return newWriter(destination, schema, headerOffset, -1)
+}
+
+// NewWriterWithCount creates a Writer for tables with the fields of the supplied schema table, writing the table
+// header, recording the given number of records, to the supplied io.Writer.
+// If the schema has memo fields, or the header cannot be written, an error is returned. As the header records the
+// next value of each Autoincrement field, but is not rewritten once the records are, schemas with Autoincrement
+// fields are rejected too; write them with NewWriter instead.
+func NewWriterWithCount(destination io.Writer, schema *DbfTable, numberOfRecords int) (*Writer, error) {
+	if numberOfRecords < 0 {
+		return nil, fmt.Errorf("number of records %d must not be negative", numberOfRecords)
+	}
+	if slices.ContainsFunc(schema.fields, func(field FieldDescriptor) bool { return field.fieldType == Autoincrement }) {
+		return nil, errors.New("writing tables with Autoincrement fields is not supported by NewWriterWithCount; use NewWriter")
+	}
+	return newWriter(destination, schema, 0, numberOfRecords)
+}
+
+func newWriter(destination io.Writer, schema *DbfTable, headerOffset int64, expectedRecords int) (*Writer, error) {
+	dt, tableErr := newWriterTable(schema)
+	if tableErr != nil {
+		return nil, tableErr
+	}
+
+	w := &Writer{destination: destination, table: dt, headerOffset: headerOffset, expectedRecords: expectedRecords}
+	if writeErr := w.writeHeader(max(expectedRecords, 0)); writeErr != nil {
+		return nil, writeErr
+	}
+	return w, nil
+}
+
+// newWriterTable creates a table with the header of the supplied schema table, last updated today, followed by space
+// for the record a Writer is writing.
+func newWriterTable(schema *DbfTable) (*DbfTable, error) {
+	if len(schema.fields) == 0 {
+		return nil, errors.New("schema has no fields defined")
+	}
+	if schema.hasMemoFields() {
+		return nil, errors.New("writing tables with memo fields is not supported by Writer")
+	}
+
+	dt := new(DbfTable)
+	dt.UseEncoding(schema.textEncoding)
+	dt.strictEncoding = schema.strictEncoding
+
+	unlock := schema.lockForReading()
+	headerBytes := append([]byte(nil), schema.dataStore[:schema.numberOfBytesInHeader]...)
+	unlock()
+	if headerErr := unpackHeader(headerBytes, dt); headerErr != nil {
+		return nil, headerErr
+	}
+	dt.RefreshLastUpdated()
+	headerBytes[1], headerBytes[2], headerBytes[3] = dt.updateYear, dt.updateMonth, dt.updateDay
+
+	dt.dataStore = append(headerBytes, make([]byte, dt.lengthOfEachRecord)...)
+	dt.numberOfRecords = 1
+	lockSchema(dt)
+
+	return dt, nil
+}
+
+// Fields return the fields of the table as a slice
+func (w *Writer) Fields() []FieldDescriptor {
+	return w.table.Fields()
+}
+
+// Write writes a record holding the supplied field values, given in field order, as SetFieldValue would store them.
+// If the number of values does not match the number of fields, or a value is incompatible with its field's type, an
+// error is returned and no record is written.
+func (w *Writer) Write(values []string) error {
+	if len(values) != len(w.table.fields) {
+		return fmt.Errorf("record has %d values, but the table has %d fields", len(values), len(w.table.fields))
+	}
+
+	return w.writeRecord(w.newRecord(func() error {
+		for i, value := range values {
+			if setErr := w.table.SetFieldValue(0, i, value); setErr != nil {
+				return setErr
+			}
+		}
+		return nil
+	}))
+}
+
+// WriteStruct writes a record holding the fields of the struct v (or the struct v points to), as DbfTable.Marshal
+// would store them.
+func (w *Writer) WriteStruct(v any) error {
+	return w.writeRecord(w.newRecord(func() error {
+		return w.table.Marshal(0, v)
+	}))
+}
+
+// newRecord returns a function filling the current record for writeRecord, which blanks the record, sets its values
+// with setValues, and numbers its Autoincrement fields.
+func (w *Writer) newRecord(setValues func() error) func(recordBytes []byte) error {
+	return func(recordBytes []byte) error {
+		fillWithBlanks(recordBytes)
+		recordBytes[recordDeletionFlagIndex] = recordIsActive
+		w.table.clearBinaryFields(recordBytes)
+
+		if setErr := setValues(); setErr != nil {
+			return setErr
+		}
+		return w.table.assignAutoincrementValues(0)
+	}
+}
+
+// clearBinaryFields zeroes the fields of the record stored as binary, and its _NullFlags field, which are empty when
+// zeroed rather than blank.
+func (dt *DbfTable) clearBinaryFields(recordBytes []byte) {
+	for i, field := range dt.fields {
+		if dt.storesBinary(field) {
+			recordOffset := dt.fieldRecordOffset(i)
+			clear(recordBytes[recordOffset:(recordOffset + int(field.length))])
+		}
+	}
+	if dt.nullFlagsField != nil {
+		clear(recordBytes[dt.nullFlagsRecordOffset:(dt.nullFlagsRecordOffset + int(dt.nullFlagsField.length))])
+	}
+}
+
+// writeRecord fills the current record with fill, and writes it to the destination.
+func (w *Writer) writeRecord(fill func(recordBytes []byte) error) error {
+	if w.closed {
+		return errors.New("writer is closed")
+	}
+	if w.expectedRecords >= 0 && w.recordsWritten >= w.expectedRecords {
+		return fmt.Errorf("writer was created for %d records, and cannot write more", w.expectedRecords)
+	}
+
+	recordBytes := w.table.dataStore[w.table.numberOfBytesInHeader:]
+	if fillErr := fill(recordBytes); fillErr != nil {
+		return fillErr
+	}
+
+	if _, writeErr := w.destination.Write(recordBytes); writeErr != nil {
+		return writeErr
+	}
+	w.recordsWritten++
+	return nil
+}
+
+// Close writes the end-of-file marker and, for a Writer created by NewWriter or OpenForAppend, rewrites the table
+// header with the number of records in the table. It does not close the destination, unless the Writer was created
+// by OpenForAppend.
+// For a Writer created by NewWriterWithCount, an error is returned if fewer records were written than it was created
+// for.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	finishErr := w.finish()
+	if w.file != nil {
+		if closeErr := w.file.Close(); closeErr != nil && finishErr == nil {
+			return closeErr
+		}
+	}
+	return finishErr
+}
+
+// finish writes the end-of-file marker, and rewrites the table header if its record count was not written up front.
+func (w *Writer) finish() error {
+	if w.expectedRecords >= 0 && w.recordsWritten != w.expectedRecords {
+		return fmt.Errorf("writer was created for %d records, but %d were written", w.expectedRecords, w.recordsWritten)
+	}
+
+	if _, writeErr := w.destination.Write([]byte{eofMarker}); writeErr != nil {
+		return writeErr
+	}
+
+	if w.expectedRecords >= 0 {
+		return nil
+	}
+
+	seeker := w.destination.(io.WriteSeeker)
+	endOffset, seekErr := seeker.Seek(0, io.SeekCurrent)
+	if seekErr != nil {
+		return seekErr
+	}
+	if _, seekErr = seeker.Seek(w.headerOffset, io.SeekStart); seekErr != nil {
+		return seekErr
+	}
+	if writeErr := w.writeHeader(w.existingRecords + w.recordsWritten); writeErr != nil {
+		return writeErr
+	}
+	_, seekErr = seeker.Seek(endOffset, io.SeekStart)
+	return seekErr
+}
+
+// writeHeader writes the table header, recording the given number of records, at the destination's current offset.
+func (w *Writer) writeHeader(numberOfRecords int) error {
+	headerBytes := w.table.dataStore[:w.table.numberOfBytesInHeader]
+	copy(headerBytes[4:8], uint32ToBytes(uint32(numberOfRecords)))
+
+	_, writeErr := w.destination.Write(headerBytes)
+	return writeErr
+}
@@ -0,0 +1,172 @@
+package godbf
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func newWriterTestSchema() *DbfTable {
+	schema := New(testEncoding)
+	schema.AddTextField("NAME", 10)
+	schema.AddNumberField("COUNT", 6, 0)
+	schema.AddBooleanField("ACTIVE")
+	return schema
+}
+
+func TestNewWriter_SeeksBackToRecordCount(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tempFilename := filepath.Join("testdata", "tempWrittenTable.dbf")
+	file, createErr := os.Create(tempFilename)
+	g.Expect(createErr).To(BeNil())
+
+	writerUnderTest, newErr := NewWriter(file, newWriterTestSchema())
+	g.Expect(newErr).To(BeNil())
+
+	g.Expect(writerUnderTest.Write([]string{"alice", "42", "T"})).To(Succeed())
+	g.Expect(writerUnderTest.WriteStruct(struct {
+		Name   string `dbf:"NAME"`
+		Count  int    `dbf:"COUNT"`
+		Active bool   `dbf:"ACTIVE"`
+	}{"bob", 7, false})).To(Succeed())
+
+	g.Expect(writerUnderTest.Close()).To(Succeed())
+	g.Expect(file.Close()).To(Succeed())
+
+	tableUnderTest, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+
+	g.Expect(tableUnderTest.NumberOfRecords()).To(Equal(2))
+	g.Expect(tableUnderTest.GetRowAsSlice(0)).To(Equal([]string{"alice", "42", "T"}))
+	g.Expect(tableUnderTest.GetRowAsSlice(1)).To(Equal([]string{"bob", "7", "F"}))
+
+	g.Expect(os.Remove(tempFilename)).To(Succeed())
+}
+
+func TestNewWriterWithCount_WritesToAnyWriter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var destination bytes.Buffer
+	writerUnderTest, newErr := NewWriterWithCount(&destination, newWriterTestSchema(), 1)
+	g.Expect(newErr).To(BeNil())
+
+	g.Expect(writerUnderTest.Write([]string{"alice", "42", "T"})).To(Succeed())
+
+	extraRecordErr := writerUnderTest.Write([]string{"bob", "7", "F"})
+	g.Expect(extraRecordErr).ToNot(BeNil())
+	t.Log(extraRecordErr)
+
+	g.Expect(writerUnderTest.Close()).To(Succeed())
+
+	tableUnderTest, loadErr := NewFromByteArray(destination.Bytes(), testEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(tableUnderTest.GetRowAsSlice(0)).To(Equal([]string{"alice", "42", "T"}))
+}
+
+func TestWriter_WriteStruct_UnsetFields_Blank(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schema := newWriterTestSchema()
+	schema.AddIntegerField("ID")
+
+	var destination bytes.Buffer
+	writerUnderTest, newErr := NewWriterWithCount(&destination, schema, 1)
+	g.Expect(newErr).To(BeNil())
+
+	g.Expect(writerUnderTest.WriteStruct(struct {
+		Name string `dbf:"NAME"`
+	}{"alice"})).To(Succeed())
+	g.Expect(writerUnderTest.Close()).To(Succeed())
+
+	tableUnderTest, loadErr := NewFromByteArray(destination.Bytes(), testEncoding)
+	g.Expect(loadErr).To(BeNil())
+
+	record, _ := tableUnderTest.recordBytes(0)
+	g.Expect(string(record[:18])).To(Equal(" alice            "))
+	g.Expect(record[18:]).To(Equal([]byte{0, 0, 0, 0}))
+	g.Expect(tableUnderTest.GetRowAsSlice(0)).To(Equal([]string{"alice", "", "", "0"}))
+}
+
+func TestNewWriterWithCount_TooFewRecords_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var destination bytes.Buffer
+	writerUnderTest, _ := NewWriterWithCount(&destination, newWriterTestSchema(), 2)
+	writerUnderTest.Write([]string{"alice", "42", "T"})
+
+	closeErr := writerUnderTest.Close()
+	g.Expect(closeErr).ToNot(BeNil())
+	t.Log(closeErr)
+}
+
+func TestWriter_Write_InvalidRecord_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var destination bytes.Buffer
+	writerUnderTest, _ := NewWriterWithCount(&destination, newWriterTestSchema(), 1)
+	headerLength := destination.Len()
+
+	g.Expect(writerUnderTest.Write([]string{"alice"})).ToNot(Succeed())
+	g.Expect(destination.Len()).To(Equal(headerLength))
+
+	g.Expect(writerUnderTest.Close()).ToNot(Succeed())
+	g.Expect(writerUnderTest.Write([]string{"alice", "42", "T"})).ToNot(Succeed())
+}
+
+func TestNewWriter_InvalidSchema_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var destination bytes.Buffer
+
+	_, noFieldsErr := NewWriterWithCount(&destination, New(testEncoding), 0)
+	g.Expect(noFieldsErr).ToNot(BeNil())
+
+	memoSchema := New(testEncoding)
+	memoSchema.AddMemoField("NOTES")
+	_, memoErr := NewWriterWithCount(&destination, memoSchema, 0)
+	g.Expect(memoErr).ToNot(BeNil())
+	t.Log(memoErr)
+}
+
+func TestNewWriter_Dbase7Autoincrement(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schema := NewDbase7(testEncoding)
+	schema.AddAutoincrementField("ID")
+	schema.AddTextField("NAME", 10)
+
+	tempFilename := filepath.Join(t.TempDir(), "autoincrement.dbf")
+	file, createErr := os.Create(tempFilename)
+	g.Expect(createErr).To(BeNil())
+
+	writerUnderTest, _ := NewWriter(file, schema)
+	writerUnderTest.Write([]string{"", "alice"})
+	writerUnderTest.Write([]string{"", "bob"})
+	g.Expect(writerUnderTest.Close()).To(Succeed())
+	g.Expect(file.Close()).To(Succeed())
+
+	tableUnderTest, loadErr := NewFromFile(tempFilename, testEncoding)
+	g.Expect(loadErr).To(BeNil())
+	g.Expect(tableUnderTest.GetRowAsSlice(0)).To(Equal([]string{"1", "alice"}))
+	g.Expect(tableUnderTest.GetRowAsSlice(1)).To(Equal([]string{"2", "bob"}))
+
+	newRow, _ := tableUnderTest.AddNewRecord()
+	g.Expect(tableUnderTest.FieldValueByName(newRow, "ID")).To(Equal("3"))
+}
+
+func TestNewWriterWithCount_Dbase7Autoincrement_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schema := NewDbase7(testEncoding)
+	schema.AddAutoincrementField("ID")
+	schema.AddTextField("NAME", 10)
+
+	var destination bytes.Buffer
+	_, newErr := NewWriterWithCount(&destination, schema, 2)
+	g.Expect(newErr).ToNot(BeNil())
+	g.Expect(destination.Len()).To(BeZero())
+}